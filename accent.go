@@ -0,0 +1,89 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Accent stripe, a vertical colored bar on the leading edge of the
+// label, independent of the background color.
+
+package colorlabel
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+)
+
+type accentState struct {
+	color any
+	width float32
+}
+
+// Shows a vertical bar with color c and the given width on the leading edge
+// A width <= 0 removes the bar
+// c is NRGBA or fyne.ThemeColorName
+func (l *ColorLabel) SetAccent(c any, width float32) error {
+	if err := ValidateColor(c); err != nil {
+		return err
+	}
+	l.accent.color = c
+	l.accent.width = max(width, 0)
+	l.Refresh()
+	return nil
+}
+
+// Get color and width of the accent bar
+func (l *ColorLabel) GetAccent() (any, float32) {
+	return l.accent.color, l.accent.width
+}
+
+func (l *ColorLabel) hasAccent() bool {
+	return l.accent.width > 0 && l.accent.color != nil
+}
+
+// Padding of the text including the space of the accent bar
+func (l *ColorLabel) contentPadding() Padding {
+	p := l.GetPadding()
+	p.Top += l.margins.Top
+	p.Right += l.margins.Right
+	p.Bottom += l.margins.Bottom
+	p.Left += l.margins.Left
+	if l.hasAccent() {
+		p.Left += l.accent.width
+	}
+	return p
+}
+
+func (r *ColorLabelRenderer) layoutAccent() {
+	if !r.w.hasAccent() {
+		if r.accent != nil {
+			r.accent.Hide()
+		}
+		return
+	}
+	if r.accent == nil {
+		r.accent = canvas.NewRectangle(nil)
+		r.updateBackgroundObjects()
+	}
+	r.accent.FillColor = r.w.renderColor(r.w.accent.color)
+	r.accent.Move(r.bg.Position())
+	r.accent.Resize(fyne.NewSize(r.w.accent.width, r.bg.Size().Height))
+	r.accent.Show()
+	r.accent.Refresh()
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Adaptive colors, custom colors with a light and a dark version which
+// follow the theme variant of the app.
+
+package colorlabel
+
+import (
+	"errors"
+	"image/color"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+
+	"github.com/bytemystery-com/colorlabel/colorutil"
+)
+
+// Color spec with a version for the light and the dark theme variant
+// It is resolved at render time, so the label follows variant switches.
+// AdaptiveColor is a color.Color too, resolved with the current variant.
+type AdaptiveColor struct {
+	Light color.Color
+	Dark  color.Color
+}
+
+// RGBA of the color for the current theme variant
+func (c AdaptiveColor) RGBA() (r, g, b, a uint32) {
+	return c.resolve(currentVariant()).RGBA()
+}
+
+func (c AdaptiveColor) resolve(variant fyne.ThemeVariant) color.Color {
+	col := c.Dark
+	if variant == theme.VariantLight {
+		col = c.Light
+	}
+	if col == nil {
+		return color.Transparent
+	}
+	return col
+}
+
+func (c AdaptiveColor) validate() error {
+	if c.Light == nil || c.Dark == nil {
+		return ErrUnsupportedColor
+	}
+	return nil
+}
+
+// Set the text color for the light and the dark theme variant
+func (l *ColorLabel) SetAdaptiveTextColor(light, dark color.Color) error {
+	return l.SetTextColor(AdaptiveColor{Light: light, Dark: dark})
+}
+
+// Set the background color for the light and the dark theme variant
+func (l *ColorLabel) SetAdaptiveBackgroundColor(light, dark color.Color) error {
+	return l.SetBackgroundColor(AdaptiveColor{Light: light, Dark: dark})
+}
+
+// Set the background color for the light and the dark theme variant
+// The text color is black or white, whichever is readable on the background.
+func (l *ColorLabel) SetAdaptiveColors(light, dark color.Color) error {
+	bg := AdaptiveColor{Light: light, Dark: dark}
+	if err := bg.validate(); err != nil {
+		return err
+	}
+	fg := AdaptiveColor{Light: colorutil.Contrast(light), Dark: colorutil.Contrast(dark)}
+	return l.SetColors(fg, bg)
+}
+
+// Parses the light and dark part of an AdaptiveColor written by colorToString
+func adaptiveFromStrings(light, dark string) (AdaptiveColor, error) {
+	var c AdaptiveColor
+	for _, p := range []struct {
+		dst *color.Color
+		src string
+	}{
+		{&c.Light, light},
+		{&c.Dark, dark},
+	} {
+		v, err := colorFromString(p.src)
+		if err != nil {
+			return AdaptiveColor{}, err
+		}
+		col, ok := v.(color.Color)
+		if !ok {
+			col, ok = cssColors[strings.ToLower(strings.TrimSpace(p.src))]
+		}
+		if !ok {
+			return AdaptiveColor{}, errors.New("invalid adaptive color " + light + "|" + dark)
+		}
+		*p.dst = col
+	}
+	return c, nil
+}
@@ -0,0 +1,138 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Prefix and suffix decorations rendered around the main text,
+// e.g. a dim "$" prefix or a "/mo" suffix.
+
+package colorlabel
+
+type affixState struct {
+	prefix *Segment
+	suffix *Segment
+	gen    int
+
+	// segments including prefix and suffix, rebuilt if the key changes
+	segs []Segment
+	key  affixKey
+}
+
+type affixKey struct {
+	affixGen int
+	baseGen  int
+	text     string
+	masked   bool
+}
+
+// Set a text shown in front of the main text, "" removes the prefix
+// Only TextColor, BackgroundColor and TextStyle of the style are used,
+// nil colors use the label colors. GetText does not contain the prefix.
+// With a prefix or suffix the label is drawn as segments, which are
+// truncated at the end for every truncate mode except None.
+func (l *ColorLabel) SetPrefix(text string, style Style) error {
+	seg, err := affixSegment(text, style)
+	if err != nil {
+		return err
+	}
+	l.affix.prefix = seg
+	l.affix.gen++
+	l.Refresh()
+	return nil
+}
+
+// Get the prefix text, "" if there is none
+func (l *ColorLabel) GetPrefix() string {
+	if l.affix.prefix == nil {
+		return ""
+	}
+	return l.affix.prefix.Text
+}
+
+// Set a text shown behind the main text, "" removes the suffix
+// Only TextColor, BackgroundColor and TextStyle of the style are used,
+// nil colors use the label colors. GetText does not contain the suffix.
+// With a prefix or suffix the label is drawn as segments, which are
+// truncated at the end for every truncate mode except None.
+func (l *ColorLabel) SetSuffix(text string, style Style) error {
+	seg, err := affixSegment(text, style)
+	if err != nil {
+		return err
+	}
+	l.affix.suffix = seg
+	l.affix.gen++
+	l.Refresh()
+	return nil
+}
+
+// Get the suffix text, "" if there is none
+func (l *ColorLabel) GetSuffix() string {
+	if l.affix.suffix == nil {
+		return ""
+	}
+	return l.affix.suffix.Text
+}
+
+func affixSegment(text string, style Style) (*Segment, error) {
+	if text == "" {
+		return nil, nil
+	}
+	if err := validateStyle(style); err != nil {
+		return nil, err
+	}
+	seg := &Segment{Text: text, TextColor: style.TextColor, BackgroundColor: style.BackgroundColor}
+	if style.TextStyle != nil {
+		s := *style.TextStyle
+		seg.TextStyle = &s
+	}
+	return seg, nil
+}
+
+// Returns the segments with prefix and suffix around the main text
+// base are the segments of the main text, nil for plain text
+func (l *ColorLabel) affixSegments(base []Segment) []Segment {
+	if l.affix.prefix == nil && l.affix.suffix == nil || l.showsPlaceholder() {
+		return base
+	}
+	key := affixKey{affixGen: l.affix.gen, baseGen: l.segmentsGen, text: l.fullText, masked: l.masked()}
+	if l.affix.segs != nil && l.affix.key == key {
+		return l.affix.segs
+	}
+	segs := make([]Segment, 0, len(base)+2)
+	if l.affix.prefix != nil {
+		segs = append(segs, *l.affix.prefix)
+	}
+	switch {
+	case base != nil:
+		segs = append(segs, base...)
+	case key.masked:
+		segs = append(segs, Segment{Text: l.maskText(l.fullText)})
+	default:
+		segs = append(segs, Segment{Text: l.fullText})
+	}
+	if l.affix.suffix != nil {
+		segs = append(segs, *l.affix.suffix)
+	}
+	l.segmentsGen++
+	key.baseGen = l.segmentsGen
+	l.affix.segs = segs
+	l.affix.key = key
+	return segs
+}
@@ -0,0 +1,251 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Parsing of ANSI SGR escape codes, so output captured from command
+// line tools can be shown with its colors.
+
+package colorlabel
+
+import (
+	"image/color"
+	"strconv"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+)
+
+// xterm default colors for the codes 30-37 and 90-97
+var ansiColors = [16]color.NRGBA{
+	{0x00, 0x00, 0x00, 0xff}, {0xcd, 0x00, 0x00, 0xff}, {0x00, 0xcd, 0x00, 0xff}, {0xcd, 0xcd, 0x00, 0xff},
+	{0x00, 0x00, 0xee, 0xff}, {0xcd, 0x00, 0xcd, 0xff}, {0x00, 0xcd, 0xcd, 0xff}, {0xe5, 0xe5, 0xe5, 0xff},
+	{0x7f, 0x7f, 0x7f, 0xff}, {0xff, 0x00, 0x00, 0xff}, {0x00, 0xff, 0x00, 0xff}, {0xff, 0xff, 0x00, 0xff},
+	{0x5c, 0x5c, 0xff, 0xff}, {0xff, 0x00, 0xff, 0xff}, {0x00, 0xff, 0xff, 0xff}, {0xff, 0xff, 0xff, 0xff},
+}
+
+type ansiState struct {
+	fg, bg    any
+	bold      bool
+	italic    bool
+	underline bool
+	reverse   bool
+}
+
+func (a *ansiState) segment(text string) Segment {
+	s := Segment{Text: text, TextColor: a.fg, BackgroundColor: a.bg}
+	if a.reverse {
+		s.TextColor, s.BackgroundColor = a.bg, a.fg
+		if s.TextColor == nil {
+			s.TextColor = theme.ColorNameBackground
+		}
+		if s.BackgroundColor == nil {
+			s.BackgroundColor = theme.ColorNameForeground
+		}
+	}
+	if a.bold || a.italic || a.underline {
+		s.TextStyle = &fyne.TextStyle{Bold: a.bold, Italic: a.italic, Underline: a.underline}
+	}
+	return s
+}
+
+// Parses text with ANSI SGR escape codes into segments
+// 16 colors, 256 colors, true color, bold, italic, underline and reverse
+// are supported, all other escape sequences are removed.
+func ParseANSI(s string) []Segment {
+	var segs []Segment
+	var state ansiState
+	var text strings.Builder
+	flush := func() {
+		if text.Len() > 0 {
+			segs = append(segs, state.segment(text.String()))
+			text.Reset()
+		}
+	}
+	for i := 0; i < len(s); {
+		if s[i] != 0x1b {
+			j := strings.IndexByte(s[i:], 0x1b)
+			if j < 0 {
+				j = len(s) - i
+			}
+			text.WriteString(s[i : i+j])
+			i += j
+			continue
+		}
+		if i+1 >= len(s) {
+			break
+		}
+		switch s[i+1] {
+		case '[':
+			// CSI: parameters up to the final byte
+			j := i + 2
+			for j < len(s) && (s[j] < 0x40 || s[j] > 0x7e) {
+				j++
+			}
+			if j >= len(s) {
+				i = len(s)
+				continue
+			}
+			if s[j] == 'm' {
+				flush()
+				state.apply(s[i+2 : j])
+			}
+			i = j + 1
+		case ']':
+			// OSC: terminated by BEL or ESC \
+			j := i + 2
+			for j < len(s) && s[j] != 0x07 && !(s[j] == 0x1b && j+1 < len(s) && s[j+1] == '\\') {
+				j++
+			}
+			if j < len(s) && s[j] == 0x1b {
+				j++
+			}
+			i = j + 1
+		default:
+			i += 2
+		}
+	}
+	flush()
+	return segs
+}
+
+// Set text with ANSI SGR escape codes
+func (l *ColorLabel) SetANSIText(s string) {
+	l.SetSegments(ParseANSI(s))
+}
+
+// Applies the SGR parameters, e.g. "1;31"
+func (a *ansiState) apply(params string) {
+	if params == "" {
+		*a = ansiState{}
+		return
+	}
+	codes := strings.Split(params, ";")
+	for i := 0; i < len(codes); i++ {
+		if strings.Contains(codes[i], ":") {
+			// ITU style sub parameters, e.g. 38:2::255:0:0
+			sub := strings.Split(codes[i], ":")
+			if c, ok := ansiExtendedColor(sub[1:], true); ok {
+				a.setExtended(sub[0], c)
+			}
+			continue
+		}
+		n, err := strconv.Atoi(codes[i])
+		if err != nil {
+			continue
+		}
+		switch {
+		case n == 0:
+			*a = ansiState{}
+		case n == 1:
+			a.bold = true
+		case n == 3:
+			a.italic = true
+		case n == 4:
+			a.underline = true
+		case n == 7:
+			a.reverse = true
+		case n == 21 || n == 22:
+			a.bold = false
+		case n == 23:
+			a.italic = false
+		case n == 24:
+			a.underline = false
+		case n == 27:
+			a.reverse = false
+		case n >= 30 && n <= 37:
+			a.fg = ansiColors[n-30]
+		case n == 39:
+			a.fg = nil
+		case n >= 40 && n <= 47:
+			a.bg = ansiColors[n-40]
+		case n == 49:
+			a.bg = nil
+		case n >= 90 && n <= 97:
+			a.fg = ansiColors[n-90+8]
+		case n >= 100 && n <= 107:
+			a.bg = ansiColors[n-100+8]
+		case n == 38 || n == 48:
+			c, ok := ansiExtendedColor(codes[i+1:], false)
+			if !ok {
+				return
+			}
+			a.setExtended(codes[i], c)
+			if codes[i+1] == "5" {
+				i += 2
+			} else {
+				i += 4
+			}
+		}
+	}
+}
+
+func (a *ansiState) setExtended(code string, c color.NRGBA) {
+	switch code {
+	case "38":
+		a.fg = c
+	case "48":
+		a.bg = c
+	}
+}
+
+// Parses "5;n" or "2;r;g;b", with colon syntax the color space id may
+// precede r, g and b
+func ansiExtendedColor(p []string, colon bool) (color.NRGBA, bool) {
+	num := func(s string) int {
+		n, err := strconv.Atoi(s)
+		if err != nil || n < 0 || n > 255 {
+			return -1
+		}
+		return n
+	}
+	if len(p) >= 2 && p[0] == "5" {
+		if n := num(p[1]); n >= 0 {
+			return ansi256(n), true
+		}
+		return color.NRGBA{}, false
+	}
+	if len(p) >= 4 && p[0] == "2" {
+		rgb := p[1:4]
+		if colon && len(p) >= 5 {
+			rgb = p[2:5]
+		}
+		r, g, b := num(rgb[0]), num(rgb[1]), num(rgb[2])
+		if r >= 0 && g >= 0 && b >= 0 {
+			return color.NRGBA{uint8(r), uint8(g), uint8(b), 0xff}, true
+		}
+	}
+	return color.NRGBA{}, false
+}
+
+// Returns a color of the xterm 256 color palette
+func ansi256(n int) color.NRGBA {
+	switch {
+	case n < 16:
+		return ansiColors[n]
+	case n < 232:
+		levels := [6]uint8{0, 95, 135, 175, 215, 255}
+		n -= 16
+		return color.NRGBA{levels[n/36], levels[n/6%6], levels[n%6], 0xff}
+	}
+	g := uint8(8 + 10*(n-232))
+	return color.NRGBA{g, g, g, 0xff}
+}
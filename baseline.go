@@ -0,0 +1,186 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Text baseline of a label and a horizontal layout aligning labels,
+// entries and standard labels on their text baseline.
+
+package colorlabel
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// Implemented by objects which know the offset of their text baseline
+type Baseliner interface {
+	// Returns the distance from the top of the object to the baseline of the first line
+	Baseline() float32
+}
+
+var (
+	_ Baseliner   = (*ColorLabel)(nil)
+	_ fyne.Layout = (*baselineLayout)(nil)
+)
+
+// Returns the distance from the top of the label to the baseline of the first text line
+// The current size is used, before the label is laid out the MinSize.
+// Rotated labels return the vertical center.
+func (l *ColorLabel) Baseline() float32 {
+	h := l.Size().Height
+	if h <= 0 {
+		h = l.MinSize().Height
+	}
+	if l.rotated() {
+		return h / 2
+	}
+	size := theme.TextSize() * l.textScale
+	style := *l.textStyle
+	if l.showsPlaceholder() {
+		size = theme.TextSize() * l.placeholderTextScale()
+		style = l.placeholderTextStyle()
+	}
+	textSize, base := renderedTextSize("M", size, style, nil)
+	pad := l.contentPadding()
+	block := textSize.Height
+	if l.wraps() {
+		n := l.wrap.linesNeeded
+		if l.wrap.maxLines > 0 {
+			n = min(n, l.wrap.maxLines)
+		}
+		if n > 1 {
+			block += float32(n-1) * textSize.Height * l.GetLineSpacing()
+		}
+	}
+	// text is centered vertically if the label is higher than the text
+	return pad.Top + max((h-pad.Top-pad.Bottom-block)/2, 0) + base
+}
+
+// Returns the baseline of an object
+// ColorLabel and other Baseliner, widget.Label and widget.Entry are supported
+func BaselineOf(o fyne.CanvasObject) (float32, bool) {
+	switch v := o.(type) {
+	case Baseliner:
+		return v.Baseline(), true
+	case *widget.Label:
+		return theme.InnerPadding() + textBaseline(v.TextStyle), true
+	case *widget.Entry:
+		return theme.InputBorderSize() + theme.InnerPadding() + textBaseline(v.TextStyle), true
+	}
+	return 0, false
+}
+
+// Baseline of text in the theme text size
+func textBaseline(style fyne.TextStyle) float32 {
+	_, base := renderedTextSize("M", theme.TextSize(), style, nil)
+	return base
+}
+
+// Returns size and baseline of a text, without a running app both are 0
+func renderedTextSize(text string, size float32, style fyne.TextStyle, source fyne.Resource) (fyne.Size, float32) {
+	a := fyne.CurrentApp()
+	if a == nil || a.Driver() == nil {
+		return fyne.Size{}, 0
+	}
+	return a.Driver().RenderedTextSize(text, size, style, source)
+}
+
+// Horizontal layout aligning the objects on their text baseline
+// Objects without a baseline are centered vertically.
+// All objects get their MinSize, the width of the container is not filled.
+type baselineLayout struct{}
+
+// Creates a horizontal layout which aligns the objects on their text baseline,
+// e.g. a ColorLabel next to a widget.Entry
+func NewBaselineLayout() fyne.Layout {
+	return &baselineLayout{}
+}
+
+// Creates a container with the objects in a row aligned on their text baseline
+func NewBaselineBox(objects ...fyne.CanvasObject) *fyne.Container {
+	return container.New(NewBaselineLayout(), objects...)
+}
+
+// Layout interface
+func (b *baselineLayout) Layout(objects []fyne.CanvasObject, size fyne.Size) {
+	pad := theme.Padding()
+	for _, o := range objects {
+		o.Resize(o.MinSize())
+	}
+	// the baselines depend on the size of the objects
+	above, below := b.extent(objects)
+	// the aligned objects are centered as a group
+	top := (size.Height - above - below) / 2
+	var x float32
+	for _, o := range objects {
+		if !o.Visible() {
+			continue
+		}
+		s := o.Size()
+		y := (size.Height - s.Height) / 2
+		if base, ok := BaselineOf(o); ok {
+			y = top + above - base
+		}
+		o.Move(fyne.NewPos(x, y))
+		x += s.Width + pad
+	}
+}
+
+// Layout interface
+func (b *baselineLayout) MinSize(objects []fyne.CanvasObject) fyne.Size {
+	pad := theme.Padding()
+	above, below := b.extent(objects)
+	h := above + below
+	var w float32
+	n := 0
+	for _, o := range objects {
+		if !o.Visible() {
+			continue
+		}
+		s := o.MinSize()
+		w += s.Width
+		n++
+		if _, ok := BaselineOf(o); !ok {
+			h = max(h, s.Height)
+		}
+	}
+	if n > 1 {
+		w += pad * float32(n-1)
+	}
+	return fyne.NewSize(w, h)
+}
+
+// Returns the largest distance above and below the common baseline
+func (b *baselineLayout) extent(objects []fyne.CanvasObject) (float32, float32) {
+	var above, below float32
+	for _, o := range objects {
+		if !o.Visible() {
+			continue
+		}
+		if base, ok := BaselineOf(o); ok {
+			above = max(above, base)
+			below = max(below, o.MinSize().Height-base)
+		}
+	}
+	return above, below
+}
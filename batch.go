@@ -0,0 +1,57 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Batched property updates, so changing several properties of a label
+// triggers only one refresh.
+
+package colorlabel
+
+type batchState struct {
+	depth   int
+	pending bool
+}
+
+// Starts a batch of property changes, refreshes are deferred until EndUpdate
+// Calls can be nested, only the outermost EndUpdate refreshes
+func (l *ColorLabel) BeginUpdate() {
+	l.batch.depth++
+}
+
+// Ends a batch of property changes
+// The label is refreshed once if any setter requested a refresh
+func (l *ColorLabel) EndUpdate() {
+	if l.batch.depth == 0 {
+		return
+	}
+	l.batch.depth--
+	if l.batch.depth == 0 && l.batch.pending {
+		l.batch.pending = false
+		l.Refresh()
+	}
+}
+
+// Calls f with the label and refreshes it at most once afterwards
+func (l *ColorLabel) Update(f func(l *ColorLabel)) {
+	l.BeginUpdate()
+	defer l.EndUpdate()
+	f(l)
+}
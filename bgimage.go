@@ -0,0 +1,179 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Background image of a ColorLabel, drawn behind the text with an
+// optional scrim color which keeps the text readable.
+
+package colorlabel
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	_ "image/jpeg"
+	_ "image/png"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+)
+
+type ImageFillType int
+
+const (
+	ImageCover ImageFillType = iota
+	ImageContain
+	ImageStretch
+	ImageTile
+)
+
+type bgImageState struct {
+	res   fyne.Resource
+	fill  ImageFillType
+	tile  image.Image
+	scrim any
+}
+
+type bgImageView struct {
+	img   *canvas.Image
+	tile  *canvas.Raster
+	scrim *canvas.Rectangle
+	src   image.Image
+	width float32
+}
+
+// Set an image drawn behind the text, nil removes the image
+// ImageTile needs a png or jpeg image, other fill types support all
+// images of canvas.Image
+func (l *ColorLabel) SetBackgroundImage(res fyne.Resource, fill ImageFillType) error {
+	var tile image.Image
+	if res != nil && fill == ImageTile {
+		img, _, err := image.Decode(bytes.NewReader(res.Content()))
+		if err != nil {
+			return err
+		}
+		tile = img
+	}
+	l.bgImage.res = res
+	l.bgImage.fill = fill
+	l.bgImage.tile = tile
+	l.Refresh()
+	return nil
+}
+
+// Get the background image and its fill type
+func (l *ColorLabel) GetBackgroundImage() (fyne.Resource, ImageFillType) {
+	return l.bgImage.res, l.bgImage.fill
+}
+
+// Set a color drawn over the background image to keep the text readable,
+// usually semi transparent, nil removes the scrim
+// c is NRGBA or fyne.ThemeColorName
+func (l *ColorLabel) SetBackgroundScrim(c any) error {
+	if err := ValidateColor(c); err != nil {
+		return err
+	}
+	l.bgImage.scrim = c
+	l.Refresh()
+	return nil
+}
+
+// Get the scrim color
+func (l *ColorLabel) GetBackgroundScrim() any {
+	return l.bgImage.scrim
+}
+
+func (r *ColorLabelRenderer) layoutBackgroundImage() {
+	s := r.w.bgImage
+	if s.res == nil {
+		if r.bgImage != nil {
+			r.bgImage.img.Hide()
+			r.bgImage.tile.Hide()
+			r.bgImage.scrim.Hide()
+		}
+		return
+	}
+	if r.bgImage == nil {
+		v := &bgImageView{img: &canvas.Image{}, scrim: canvas.NewRectangle(nil)}
+		v.tile = canvas.NewRasterWithPixels(v.pixel)
+		r.bgImage = v
+		r.updateBackgroundObjects()
+	}
+	v := r.bgImage
+	pos, size := r.bg.Position(), r.bg.Size()
+	if s.fill == ImageTile {
+		v.img.Hide()
+		v.src = s.tile
+		v.width = size.Width
+		v.tile.Move(pos)
+		v.tile.Resize(size)
+		v.tile.Show()
+		v.tile.Refresh()
+	} else {
+		v.tile.Hide()
+		if v.img.Resource != s.res {
+			v.img.Resource = s.res
+			v.img.File = ""
+			v.img.Image = nil
+		}
+		switch s.fill {
+		case ImageContain:
+			v.img.FillMode = canvas.ImageFillContain
+		case ImageStretch:
+			v.img.FillMode = canvas.ImageFillStretch
+		default:
+			v.img.FillMode = canvas.ImageFillCover
+		}
+		v.img.Translucency = 0
+		if r.w.fade.active {
+			v.img.Translucency = float64(1 - r.w.fade.alpha)
+		}
+		v.img.Move(pos)
+		v.img.Resize(size)
+		v.img.Show()
+		v.img.Refresh()
+	}
+	if s.scrim == nil {
+		v.scrim.Hide()
+		return
+	}
+	v.scrim.FillColor = r.w.renderColor(s.scrim)
+	v.scrim.Move(pos)
+	v.scrim.Resize(size)
+	v.scrim.Show()
+	v.scrim.Refresh()
+}
+
+// Pixel function of the tiled image, w is in pixels so the image is scaled
+// to its size in device independent units
+func (v *bgImageView) pixel(x, y, w, _ int) color.Color {
+	if v.src == nil || v.src.Bounds().Empty() {
+		return color.Transparent
+	}
+	scale := float32(1)
+	if v.width > 0 {
+		scale = float32(w) / v.width
+	}
+	b := v.src.Bounds()
+	tx := int(float32(x)/scale) % b.Dx()
+	ty := int(float32(y)/scale) % b.Dy()
+	return v.src.At(b.Min.X+tx, b.Min.Y+ty)
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Binding of numeric values, rendered through a format string.
+
+package colorlabel
+
+import (
+	"fmt"
+
+	"fyne.io/fyne/v2/data/binding"
+)
+
+type bindState struct {
+	unbind func()
+}
+
+// Binds the label to a float, the text is rendered with format, e.g. "%.2f MB"
+// An empty format uses "%g". Thresholds set by SetValueRules are applied.
+func (l *ColorLabel) BindFloat(data binding.Float, format string) {
+	if format == "" {
+		format = "%g"
+	}
+	l.bindValue(data, func() {
+		v, err := data.Get()
+		if err == nil {
+			l.showValue(v, fmt.Sprintf(format, v))
+		}
+	})
+}
+
+// Binds the label to an int, the text is rendered with format, e.g. "%d items"
+// An empty format uses "%d". Thresholds set by SetValueRules are applied.
+func (l *ColorLabel) BindInt(data binding.Int, format string) {
+	if format == "" {
+		format = "%d"
+	}
+	l.bindValue(data, func() {
+		v, err := data.Get()
+		if err == nil {
+			l.showValue(float64(v), fmt.Sprintf(format, v))
+		}
+	})
+}
+
+// Removes a binding set by BindFloat, BindInt or SetTemplate
+// Only one of them is bound at a time, each replaces the others.
+func (l *ColorLabel) Unbind() {
+	if l.bind.unbind != nil {
+		l.bind.unbind()
+		l.bind.unbind = nil
+	}
+}
+
+func (l *ColorLabel) bindValue(data binding.DataItem, update func()) {
+	l.clearTemplate()
+	l.Unbind()
+	listener := binding.NewDataListener(update)
+	data.AddListener(listener)
+	l.bind.unbind = func() {
+		data.RemoveListener(listener)
+	}
+}
+
+// Shows a numeric value with the colors selected by the thresholds
+func (l *ColorLabel) showValue(v float64, text string) {
+	l.value.last = v
+	l.value.valid = true
+	l.applyValueColors(v)
+	l.fullText = text
+	l.segments = nil
+	l.highlightBase = nil
+	l.Refresh()
+}
@@ -0,0 +1,222 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Breadcrumb shows a path as tappable ColorLabels. Middle segments
+// collapse to an ellipsis when the bar gets too narrow.
+
+package colorlabel
+
+import (
+	"slices"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+var _ fyne.Widget = (*Breadcrumb)(nil)
+
+// Path bar made of tappable ColorLabels
+// Implements
+//   - fyne.Widget
+type Breadcrumb struct {
+	widget.BaseWidget
+
+	path      []string
+	separator string
+
+	segs     []*ColorLabel
+	seps     []*ColorLabel
+	ellipsis *ColorLabel
+	ellSep   *ColorLabel
+	// first and last (exclusive) index of the collapsed segments
+	collapseFrom int
+	collapseTo   int
+
+	// Called with the index of the tapped segment
+	OnSegmentTapped func(index int)
+}
+
+// Creates a new Breadcrumb for the path segments
+func NewBreadcrumb(path ...string) *Breadcrumb {
+	b := &Breadcrumb{
+		separator: "›",
+	}
+	b.ellipsis = NewColorLabel(defaultEllipsis, theme.ColorNamePrimary, nil, 1)
+	b.ellipsis.OnTappedEx = b.showCollapsed
+	b.ellSep = NewColorLabel(b.separator, theme.ColorNameDisabled, nil, 1)
+	b.ExtendBaseWidget(b)
+	b.SetPath(path)
+	return b
+}
+
+// Widget interface
+func (b *Breadcrumb) CreateRenderer() fyne.WidgetRenderer {
+	r := &breadcrumbRenderer{b: b}
+	r.Refresh()
+	return r
+}
+
+// Set new path segments
+func (b *Breadcrumb) SetPath(path []string) {
+	b.path = slices.Clone(path)
+	b.segs = make([]*ColorLabel, len(path))
+	b.seps = make([]*ColorLabel, len(path))
+	for i, s := range path {
+		l := NewColorLabel(s, theme.ColorNamePrimary, nil, 1)
+		if i == len(path)-1 {
+			l.SetTextColor(theme.ColorNameForeground)
+			l.SetTextStyle(&fyne.TextStyle{Bold: true})
+		}
+		l.OnTapped = func() {
+			b.tapped(i)
+		}
+		b.segs[i] = l
+		b.seps[i] = NewColorLabel(b.separator, theme.ColorNameDisabled, nil, 1)
+	}
+	b.Refresh()
+}
+
+// Get a copy of the path segments
+func (b *Breadcrumb) GetPath() []string {
+	return slices.Clone(b.path)
+}
+
+// Set the separator shown between segments, default is "›"
+func (b *Breadcrumb) SetSeparator(s string) {
+	b.separator = s
+	for _, l := range b.seps {
+		l.SetText(s)
+	}
+	b.ellSep.SetText(s)
+	b.Refresh()
+}
+
+// Get the separator
+func (b *Breadcrumb) GetSeparator() string {
+	return b.separator
+}
+
+func (b *Breadcrumb) tapped(index int) {
+	if b.OnSegmentTapped != nil {
+		b.OnSegmentTapped(index)
+	}
+}
+
+// Shows the collapsed segments as menu below the ellipsis
+func (b *Breadcrumb) showCollapsed(ev *fyne.PointEvent) {
+	c := fyne.CurrentApp().Driver().CanvasForObject(b)
+	if c == nil || b.collapseFrom >= b.collapseTo {
+		return
+	}
+	items := make([]*fyne.MenuItem, 0, b.collapseTo-b.collapseFrom)
+	for i := b.collapseFrom; i < b.collapseTo; i++ {
+		items = append(items, fyne.NewMenuItem(b.path[i], func() {
+			b.tapped(i)
+		}))
+	}
+	widget.ShowPopUpMenuAtPosition(fyne.NewMenu("", items...), c, ev.AbsolutePosition)
+}
+
+type breadcrumbRenderer struct {
+	b    *Breadcrumb
+	objs []fyne.CanvasObject
+}
+
+// Returns the objects to show in order when segments [from, to) are collapsed
+func (r *breadcrumbRenderer) visible(from, to int) []fyne.CanvasObject {
+	b := r.b
+	var objs []fyne.CanvasObject
+	for i, l := range b.segs {
+		if i >= from && i < to {
+			if i == from {
+				objs = append(objs, b.ellSep, b.ellipsis)
+			}
+			continue
+		}
+		if len(objs) > 0 {
+			objs = append(objs, b.seps[i])
+		}
+		objs = append(objs, l)
+	}
+	return objs
+}
+
+func (r *breadcrumbRenderer) width(objs []fyne.CanvasObject) float32 {
+	var w float32
+	for _, o := range objs {
+		w += o.MinSize().Width
+	}
+	return w
+}
+
+// WidgetRenderer interface
+func (r *breadcrumbRenderer) Layout(size fyne.Size) {
+	b := r.b
+	b.collapseFrom, b.collapseTo = 1, 1
+	objs := r.visible(1, 1)
+	// collapse from the second segment on, first and last always stay
+	for to := 2; to < len(b.segs) && r.width(objs) > size.Width; to++ {
+		b.collapseTo = to
+		objs = r.visible(1, to)
+	}
+	for _, o := range r.objs {
+		o.Hide()
+	}
+	var x float32
+	for _, o := range objs {
+		s := o.MinSize()
+		o.Resize(fyne.NewSize(s.Width, size.Height))
+		o.Move(fyne.NewPos(x, 0))
+		o.Show()
+		x += s.Width
+	}
+}
+
+// WidgetRenderer interface
+func (r *breadcrumbRenderer) MinSize() fyne.Size {
+	objs := r.visible(1, max(1, len(r.b.segs)-1))
+	var h float32
+	for _, o := range objs {
+		h = max(h, o.MinSize().Height)
+	}
+	return fyne.NewSize(r.width(objs), h)
+}
+
+// WidgetRenderer interface
+func (r *breadcrumbRenderer) Refresh() {
+	b := r.b
+	r.objs = []fyne.CanvasObject{b.ellSep, b.ellipsis}
+	for i := range b.segs {
+		r.objs = append(r.objs, b.seps[i], b.segs[i])
+	}
+	r.Layout(b.Size())
+}
+
+// WidgetRenderer interface
+func (r *breadcrumbRenderer) Destroy() {
+}
+
+// WidgetRenderer interface
+func (r *breadcrumbRenderer) Objects() []fyne.CanvasObject {
+	return r.objs
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Builds many labels at once from a declarative description,
+// e.g. for tag sets or legend entries.
+
+package colorlabel
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/layout"
+)
+
+// Declarative description of a ColorLabel
+// TextColor and BackgroundColor are NRGBA or fyne.ThemeColorName
+type LabelSpec struct {
+	Text            string
+	TextColor       any
+	BackgroundColor any
+	TextScale       float32
+	TextStyle       *fyne.TextStyle
+	Truncate        TruncateModeType
+	Alignment       fyne.TextAlign
+
+	OnTapped          func()
+	OnTappedSecondary func()
+	OnDoubleTapped    func()
+}
+
+// Creates a new ColorLabel from a spec
+// Returns nil if a color of the spec is not supported
+func NewColorLabelFromSpec(spec LabelSpec) *ColorLabel {
+	l := NewColorLabel(spec.Text, spec.TextColor, spec.BackgroundColor, spec.TextScale)
+	if l == nil {
+		return nil
+	}
+	if spec.TextStyle != nil {
+		style := *spec.TextStyle
+		l.textStyle = &style
+	}
+	l.truncate = spec.Truncate
+	l.alignment = spec.Alignment
+	l.OnTapped = spec.OnTapped
+	l.OnTappedSecondary = spec.OnTappedSecondary
+	l.OnDoubleTapped = spec.OnDoubleTapped
+	return l
+}
+
+// Creates a ColorLabel for every spec
+// The entry of a spec with an unsupported color is nil, so the labels keep
+// the indices of the specs.
+func BuildLabels(items []LabelSpec) []*ColorLabel {
+	labels := make([]*ColorLabel, len(items))
+	for i, item := range items {
+		labels[i] = NewColorLabelFromSpec(item)
+	}
+	return labels
+}
+
+// Creates a ColorLabel for every spec and puts them into a container
+// If lay is nil a vertical box layout is used.
+// Specs with an unsupported color are skipped.
+func BuildLabelsContainer(lay fyne.Layout, items []LabelSpec) *fyne.Container {
+	if lay == nil {
+		lay = layout.NewVBoxLayout()
+	}
+	objs := make([]fyne.CanvasObject, 0, len(items))
+	for _, l := range BuildLabels(items) {
+		if l != nil {
+			objs = append(objs, l)
+		}
+	}
+	return container.New(lay, objs...)
+}
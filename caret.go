@@ -0,0 +1,186 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Optional caret marker for ColorLabel, which marks a position inside
+// the text for read only "player" or "follow" views.
+
+package colorlabel
+
+import (
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+)
+
+const caretWidth = 2
+
+type caretState struct {
+	index   int
+	visible bool
+	blink   bool
+	color   any
+}
+
+// Show a caret marker in front of the rune with the given index
+// A negative index hides the caret
+// If blink is true the caret is blinking
+func (l *ColorLabel) SetCaret(index int, blink bool) {
+	l.caret.index = index
+	l.caret.visible = index >= 0
+	l.caret.blink = blink
+	l.Refresh()
+}
+
+// Get the rune index of the caret, -1 if hidden
+func (l *ColorLabel) GetCaret() int {
+	if !l.caret.visible {
+		return -1
+	}
+	return l.caret.index
+}
+
+// Set the caret color
+// c is NRGBA or fyne.ThemeColorName, nil uses the text color
+func (l *ColorLabel) SetCaretColor(c any) error {
+	if err := ValidateColor(c); err != nil {
+		return err
+	}
+	l.caret.color = c
+	l.Refresh()
+	return nil
+}
+
+// Returns the displayed text in front of the caret
+// or false if the caret position is not visible
+func (r *ColorLabelRenderer) caretPrefix() (string, bool) {
+	full := []rune(r.source)
+	index := min(r.w.caret.index, len(full))
+	t := r.measuredText
+	if !r.w.truncated || index <= t.head {
+		return string(full[:index]), true
+	}
+	start := len(full) - t.tail
+	if index >= start {
+		return string(full[:t.head]) + r.w.GetEllipsis() + string(full[start:index]), true
+	}
+	return "", false
+}
+
+func (r *ColorLabelRenderer) layoutCaret() {
+	if !r.w.caret.visible || r.w.wraps() {
+		r.stopCaretAnimation()
+		r.caret.Hide()
+		return
+	}
+	var x float32
+	var ok bool
+	if r.letters != nil && r.letters.box.Visible() {
+		x, ok = r.spacedCaretX()
+	} else {
+		x, ok = r.caretX()
+	}
+	if !ok {
+		r.stopCaretAnimation()
+		r.caret.Hide()
+		return
+	}
+	h := r.text.MinSize().Height
+	r.caret.Move(fyne.NewPos(x-caretWidth/2, r.text.Position().Y))
+	r.caret.Resize(fyne.NewSize(caretWidth, h))
+	c := r.w.caret.color
+	if c == nil {
+		c, _ = r.w.stateColors()
+	}
+	r.caret.FillColor = r.w.renderColor(c)
+	if r.w.caret.blink {
+		r.startCaretAnimation()
+	} else {
+		r.stopCaretAnimation()
+		r.caret.Show()
+	}
+	r.caret.Refresh()
+}
+
+// Returns the x position of the caret in the text
+// or false if the caret position is not visible
+func (r *ColorLabelRenderer) caretX() (float32, bool) {
+	prefix, ok := r.caretPrefix()
+	if !ok {
+		return 0, false
+	}
+	textW := fyne.MeasureText(r.text.Text, r.text.TextSize, r.text.TextStyle).Width
+	prefixW := fyne.MeasureText(prefix, r.text.TextSize, r.text.TextStyle).Width
+	x := r.text.Position().X
+	switch r.text.Alignment {
+	case fyne.TextAlignCenter:
+		x += (r.text.Size().Width - textW) / 2
+	case fyne.TextAlignTrailing:
+		x += r.text.Size().Width - textW
+	}
+	return x + prefixW, true
+}
+
+// Returns the x position of the caret in the letter spaced text
+// or false if the caret position is not visible
+func (r *ColorLabelRenderer) spacedCaretX() (float32, bool) {
+	full := []rune(r.source)
+	index := min(r.w.caret.index, len(full))
+	k := len(graphemes(string(full[:index])))
+	v := r.letters
+	if k > v.kept || k >= len(v.xs) {
+		return 0, false
+	}
+	x := v.xs[k]
+	if k > 0 && k < len(v.xs)-1 {
+		// in the middle of the space between the letters
+		x -= r.w.letterSpacing / 2
+	}
+	return v.box.Position().X + x, true
+}
+
+func (r *ColorLabelRenderer) startCaretAnimation() {
+	if r.caretAnim != nil {
+		return
+	}
+	r.caretAnim = fyne.NewAnimation(time.Second, func(f float32) {
+		r.caret.Hidden = f >= 0.5
+		r.caret.Refresh()
+	})
+	r.caretAnim.Curve = fyne.AnimationLinear
+	r.caretAnim.RepeatCount = fyne.AnimationRepeatForever
+	r.caretAnim.Start()
+}
+
+func (r *ColorLabelRenderer) stopCaretAnimation() {
+	if r.caretAnim != nil {
+		r.caretAnim.Stop()
+		r.caretAnim = nil
+	}
+}
+
+func newCaret() *canvas.Rectangle {
+	c := canvas.NewRectangle(theme.Color(theme.ColorNameForeground))
+	c.Hide()
+	return c
+}
@@ -0,0 +1,142 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Change notification for text and style properties, so wrappers like
+// auto sizing containers or undo systems can react without polling.
+
+package colorlabel
+
+import (
+	"image/color"
+
+	"fyne.io/fyne/v2"
+)
+
+type ChangeType int
+
+const (
+	ChangeText ChangeType = 1 << iota
+	ChangeStyle
+)
+
+// Properties compared for detecting a change
+type changeSnapshot struct {
+	valid       bool
+	text        string
+	fgColor     any
+	bgColor     any
+	textScale   float32
+	textStyle   fyne.TextStyle
+	truncate    TruncateModeType
+	alignment   fyne.TextAlign
+	borderColor any
+	borderWidth float32
+	padding     Padding
+	margins     Padding
+	transform   TextTransformType
+}
+
+func (l *ColorLabel) takeSnapshot() changeSnapshot {
+	s := changeSnapshot{
+		valid:       true,
+		text:        l.fullText,
+		fgColor:     l.fgColor,
+		bgColor:     l.bgColor,
+		textScale:   l.textScale,
+		truncate:    l.truncate,
+		alignment:   l.alignment,
+		borderColor: l.borderColor,
+		borderWidth: l.borderWidth,
+		margins:     l.margins,
+		transform:   l.transform,
+	}
+	if l.textStyle != nil {
+		s.textStyle = *l.textStyle
+	}
+	if l.padding != nil {
+		s.padding = *l.padding
+	}
+	return s
+}
+
+// Compares the properties with the last snapshot and calls OnChanged
+// The first call only records the properties
+func (l *ColorLabel) notifyChanged() {
+	s := l.takeSnapshot()
+	last := l.snapshot
+	l.snapshot = s
+	if !last.valid || l.OnChanged == nil {
+		return
+	}
+	var change ChangeType
+	if s.text != last.text {
+		change |= ChangeText
+	}
+	if !s.sameStyle(last) {
+		change |= ChangeStyle
+	}
+	if change != 0 {
+		l.OnChanged(change)
+	}
+}
+
+// Reports if all properties except the text are equal
+func (s changeSnapshot) sameStyle(o changeSnapshot) bool {
+	return sameColor(s.fgColor, o.fgColor) &&
+		sameColor(s.bgColor, o.bgColor) &&
+		sameColor(s.borderColor, o.borderColor) &&
+		s.textScale == o.textScale &&
+		s.textStyle == o.textStyle &&
+		s.truncate == o.truncate &&
+		s.alignment == o.alignment &&
+		s.borderWidth == o.borderWidth &&
+		s.padding == o.padding &&
+		s.margins == o.margins &&
+		s.transform == o.transform
+}
+
+// Compares two colors without panicking for values which are not comparable
+// Colors are compared by their RGBA values, theme names by name.
+func sameColor(a, b any) bool {
+	switch v := a.(type) {
+	case nil:
+		return b == nil
+	case string:
+		w, ok := b.(string)
+		return ok && v == w
+	case fyne.ThemeColorName:
+		w, ok := b.(fyne.ThemeColorName)
+		return ok && v == w
+	case AdaptiveColor:
+		w, ok := b.(AdaptiveColor)
+		return ok && sameColor(v.Light, w.Light) && sameColor(v.Dark, w.Dark)
+	case color.Color:
+		w, ok := b.(color.Color)
+		if !ok {
+			return false
+		}
+		r1, g1, b1, a1 := v.RGBA()
+		r2, g2, b2, a2 := w.RGBA()
+		return r1 == r2 && g1 == g2 && b1 == b2 && a1 == a2
+	}
+	return false
+}
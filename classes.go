@@ -0,0 +1,92 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Package wide registry of named styles, similar to CSS classes,
+// so labels are styled consistently across an app.
+
+package colorlabel
+
+import (
+	"sync"
+)
+
+var (
+	classesLock   sync.RWMutex
+	classes       = make(map[string]Style)
+	defaultsStyle Style
+)
+
+// Set the style used by NewColorLabelClass for unknown or empty class names
+func SetDefaults(style Style) error {
+	if err := validateStyle(style); err != nil {
+		return err
+	}
+	classesLock.Lock()
+	defer classesLock.Unlock()
+	defaultsStyle = style
+	return nil
+}
+
+// Registers a named style
+func RegisterClass(name string, style Style) error {
+	if err := validateStyle(style); err != nil {
+		return err
+	}
+	classesLock.Lock()
+	defer classesLock.Unlock()
+	classes[name] = style
+	return nil
+}
+
+// Get a registered style, unknown names return the defaults and false
+func GetClass(name string) (Style, bool) {
+	classesLock.RLock()
+	defer classesLock.RUnlock()
+	s, ok := classes[name]
+	if !ok {
+		return defaultsStyle, false
+	}
+	return s, true
+}
+
+// Creates a new ColorLabel styled by a registered class
+func NewColorLabelClass(class, s string) *ColorLabel {
+	style, _ := GetClass(class)
+	l := NewColorLabelWithStyle(s, style)
+	l.class = class
+	return l
+}
+
+// Applies the style of a registered class
+func (l *ColorLabel) SetClass(class string) error {
+	style, _ := GetClass(class)
+	if err := l.ApplyStyle(style); err != nil {
+		return err
+	}
+	l.class = class
+	return nil
+}
+
+// Get the class name of the label
+func (l *ColorLabel) GetClass() string {
+	return l.class
+}
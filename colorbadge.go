@@ -0,0 +1,201 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// ColorBadge is a small rounded pill showing a counter, e.g. the number
+// of unread messages. It hides itself at zero and caps large numbers.
+
+package colorlabel
+
+import (
+	"strconv"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+var _ fyne.Widget = (*ColorBadge)(nil)
+
+// Counter badge with colored rounded background
+// Implements
+//   - fyne.Widget
+type ColorBadge struct {
+	widget.BaseWidget
+
+	count     int
+	maxCount  int
+	fgColor   any
+	bgColor   any
+	textScale float32
+}
+
+// Creates a new ColorBadge
+// txtColor and backColor are NRGBA or fyne.ThemeColorName, nil uses the theme error colors
+// Returns nil if a color is not supported
+func NewColorBadge(count int, txtColor, backColor any) *ColorBadge {
+	if isDefaultColor(txtColor) {
+		txtColor = theme.ColorNameForegroundOnError
+	}
+	if isDefaultColor(backColor) {
+		backColor = theme.ColorNameError
+	}
+	if ValidateColor(txtColor) != nil || ValidateColor(backColor) != nil {
+		return nil
+	}
+	b := &ColorBadge{
+		maxCount:  99,
+		fgColor:   txtColor,
+		bgColor:   backColor,
+		textScale: 0.8,
+	}
+	b.ExtendBaseWidget(b)
+	b.SetCount(count)
+	return b
+}
+
+// Widget interface
+func (b *ColorBadge) CreateRenderer() fyne.WidgetRenderer {
+	t := canvas.NewText(b.text(), getColor(b.fgColor))
+	t.Alignment = fyne.TextAlignCenter
+	t.TextStyle = fyne.TextStyle{Bold: true}
+	bg := canvas.NewRectangle(getColor(b.bgColor))
+	r := &colorBadgeRenderer{
+		b:    b,
+		text: t,
+		bg:   bg,
+		objs: []fyne.CanvasObject{bg, t},
+	}
+	r.Refresh()
+	return r
+}
+
+// Set the counter, the badge is hidden at zero
+func (b *ColorBadge) SetCount(count int) {
+	b.count = count
+	if count == 0 {
+		b.Hide()
+	} else {
+		b.Show()
+	}
+	b.Refresh()
+}
+
+// Get the counter
+func (b *ColorBadge) GetCount() int {
+	return b.count
+}
+
+// Set the largest number shown, larger numbers are shown as e.g. "99+"
+func (b *ColorBadge) SetMax(maxCount int) {
+	if maxCount > 0 && b.maxCount != maxCount {
+		b.maxCount = maxCount
+		b.Refresh()
+	}
+}
+
+// Set new text color
+// txtColor is NRGBA or fyne.ThemeColorName
+func (b *ColorBadge) SetTextColor(txtColor any) error {
+	if err := ValidateColor(txtColor); err != nil {
+		return err
+	}
+	if isDefaultColor(txtColor) {
+		txtColor = theme.ColorNameForegroundOnError
+	}
+	b.fgColor = txtColor
+	b.Refresh()
+	return nil
+}
+
+// Set new background color
+// backColor is NRGBA or fyne.ThemeColorName
+func (b *ColorBadge) SetBackgroundColor(backColor any) error {
+	if err := ValidateColor(backColor); err != nil {
+		return err
+	}
+	if isDefaultColor(backColor) {
+		backColor = theme.ColorNameError
+	}
+	b.bgColor = backColor
+	b.Refresh()
+	return nil
+}
+
+// Set new text scale factor
+func (b *ColorBadge) SetTextScale(tScale float32) {
+	if tScale <= 0 {
+		tScale = 1
+	}
+	b.textScale = tScale
+	b.Refresh()
+}
+
+func (b *ColorBadge) text() string {
+	if b.count > b.maxCount {
+		return strconv.Itoa(b.maxCount) + "+"
+	}
+	return strconv.Itoa(b.count)
+}
+
+type colorBadgeRenderer struct {
+	b    *ColorBadge
+	text *canvas.Text
+	bg   *canvas.Rectangle
+	objs []fyne.CanvasObject
+}
+
+// WidgetRenderer interface
+func (r *colorBadgeRenderer) Layout(size fyne.Size) {
+	r.bg.Resize(size)
+	r.bg.CornerRadius = size.Height / 2
+	ts := r.text.MinSize()
+	r.text.Resize(fyne.NewSize(size.Width, ts.Height))
+	r.text.Move(fyne.NewPos(0, (size.Height-ts.Height)/2))
+}
+
+// WidgetRenderer interface
+func (r *colorBadgeRenderer) MinSize() fyne.Size {
+	ts := r.text.MinSize()
+	h := ts.Height + theme.Padding()
+	return fyne.NewSize(max(ts.Width+2*theme.Padding(), h), h)
+}
+
+// WidgetRenderer interface
+func (r *colorBadgeRenderer) Refresh() {
+	r.text.Text = r.b.text()
+	r.text.TextSize = theme.TextSize() * r.b.textScale
+	r.text.Color = getColor(r.b.fgColor)
+	r.text.Refresh()
+	r.bg.FillColor = getColor(r.b.bgColor)
+	r.bg.Refresh()
+	r.Layout(r.b.Size())
+}
+
+// WidgetRenderer interface
+func (r *colorBadgeRenderer) Destroy() {
+}
+
+// WidgetRenderer interface
+func (r *colorBadgeRenderer) Objects() []fyne.CanvasObject {
+	return r.objs
+}
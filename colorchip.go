@@ -0,0 +1,266 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// ColorChip is a rounded tag with text, an optional leading icon and
+// a trailing delete mark.
+
+package colorlabel
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+var (
+	_ fyne.Widget        = (*ColorChip)(nil)
+	_ fyne.Tappable      = (*ColorChip)(nil)
+	_ desktop.Cursorable = (*ColorChip)(nil)
+)
+
+const chipDeleteMark = "×"
+
+// Tag with colored rounded background
+// The delete mark is only shown when OnDeleted is set.
+// Implements
+//   - fyne.Widget
+//   - fyne.Tappable
+//   - desktop.Cursorable
+type ColorChip struct {
+	widget.BaseWidget
+
+	text      string
+	icon      fyne.Resource
+	fgColor   any
+	bgColor   any
+	textScale float32
+
+	OnTapped  func()
+	OnDeleted func()
+}
+
+// Creates a new ColorChip
+// icon can be nil
+// txtColor and backColor are NRGBA or fyne.ThemeColorName, nil uses the theme defaults
+// Returns nil if a color is not supported
+func NewColorChip(s string, icon fyne.Resource, txtColor, backColor any) *ColorChip {
+	txtColor, err := normalizeTextColor(txtColor)
+	if err != nil {
+		return nil
+	}
+	if isDefaultColor(backColor) {
+		backColor = theme.ColorNameButton
+	}
+	if ValidateColor(backColor) != nil {
+		return nil
+	}
+	c := &ColorChip{
+		text:      s,
+		icon:      icon,
+		fgColor:   txtColor,
+		bgColor:   backColor,
+		textScale: 1,
+	}
+	c.ExtendBaseWidget(c)
+	return c
+}
+
+// Widget interface
+func (c *ColorChip) CreateRenderer() fyne.WidgetRenderer {
+	t := canvas.NewText(c.text, getColor(c.fgColor))
+	del := canvas.NewText(chipDeleteMark, getColor(c.fgColor))
+	del.TextStyle = fyne.TextStyle{Bold: true}
+	r := &colorChipRenderer{
+		c:    c,
+		text: t,
+		del:  del,
+		icon: widget.NewIcon(c.icon),
+		bg:   canvas.NewRectangle(getColor(c.bgColor)),
+	}
+	r.objs = []fyne.CanvasObject{r.bg, r.icon, r.text, r.del}
+	r.Refresh()
+	return r
+}
+
+// Tappable interface
+func (c *ColorChip) Tapped(ev *fyne.PointEvent) {
+	if c.OnDeleted != nil && ev.Position.X >= c.deleteX() {
+		c.OnDeleted()
+		return
+	}
+	if c.OnTapped != nil {
+		c.OnTapped()
+	}
+}
+
+// Cursorable interface
+func (c *ColorChip) Cursor() desktop.Cursor {
+	if c.OnTapped != nil || c.OnDeleted != nil {
+		return desktop.PointerCursor
+	}
+	return desktop.DefaultCursor
+}
+
+// Set new text
+func (c *ColorChip) SetText(s string) {
+	c.text = s
+	c.Refresh()
+}
+
+// Get the text
+func (c *ColorChip) GetText() string {
+	return c.text
+}
+
+// Set a new leading icon, nil removes the icon
+func (c *ColorChip) SetIcon(icon fyne.Resource) {
+	c.icon = icon
+	c.Refresh()
+}
+
+// Set new text color
+// txtColor is NRGBA or fyne.ThemeColorName
+func (c *ColorChip) SetTextColor(txtColor any) error {
+	txtColor, err := normalizeTextColor(txtColor)
+	if err != nil {
+		return err
+	}
+	c.fgColor = txtColor
+	c.Refresh()
+	return nil
+}
+
+// Set new background color
+// backColor is NRGBA or fyne.ThemeColorName
+func (c *ColorChip) SetBackgroundColor(backColor any) error {
+	if err := ValidateColor(backColor); err != nil {
+		return err
+	}
+	if isDefaultColor(backColor) {
+		backColor = theme.ColorNameButton
+	}
+	c.bgColor = backColor
+	c.Refresh()
+	return nil
+}
+
+// Set new text scale factor
+func (c *ColorChip) SetTextScale(tScale float32) {
+	if tScale <= 0 {
+		tScale = 1
+	}
+	c.textScale = tScale
+	c.Refresh()
+}
+
+// x position where the delete area starts
+func (c *ColorChip) deleteX() float32 {
+	pos, _ := c.deleteMark(c.Size())
+	return pos.X
+}
+
+// Returns position and size of the delete mark in a chip of the given size
+func (c *ColorChip) deleteMark(size fyne.Size) (fyne.Position, fyne.Size) {
+	p := theme.Padding()
+	textSize := theme.TextSize() * c.textScale
+	th := fyne.MeasureText(c.text, textSize, fyne.TextStyle{}).Height
+	ds := fyne.MeasureText(chipDeleteMark, textSize, fyne.TextStyle{Bold: true})
+	end := (th + p) / 2
+	return fyne.NewPos(size.Width-ds.Width-end+p, (size.Height-ds.Height)/2), ds
+}
+
+type colorChipRenderer struct {
+	c    *ColorChip
+	text *canvas.Text
+	del  *canvas.Text
+	icon *widget.Icon
+	bg   *canvas.Rectangle
+	objs []fyne.CanvasObject
+}
+
+// WidgetRenderer interface
+func (r *colorChipRenderer) Layout(size fyne.Size) {
+	p := theme.Padding()
+	r.bg.Resize(size)
+	r.bg.CornerRadius = size.Height / 2
+
+	ts := r.text.MinSize()
+	end := (ts.Height + p) / 2
+	x := end
+	if r.c.icon != nil {
+		is := ts.Height
+		r.icon.Resize(fyne.NewSquareSize(is))
+		r.icon.Move(fyne.NewPos(x-p, (size.Height-is)/2))
+		x += is
+	}
+	r.text.Resize(ts)
+	r.text.Move(fyne.NewPos(x, (size.Height-ts.Height)/2))
+
+	pos, ds := r.c.deleteMark(size)
+	r.del.Resize(ds)
+	r.del.Move(pos)
+}
+
+// WidgetRenderer interface
+func (r *colorChipRenderer) MinSize() fyne.Size {
+	p := theme.Padding()
+	ts := r.text.MinSize()
+	h := ts.Height + p
+	w := ts.Width + h
+	if r.c.icon != nil {
+		w += ts.Height
+	}
+	if r.c.OnDeleted != nil {
+		w += r.del.MinSize().Width + p
+	}
+	return fyne.NewSize(w, h)
+}
+
+// WidgetRenderer interface
+func (r *colorChipRenderer) Refresh() {
+	fg := getColor(r.c.fgColor)
+	size := theme.TextSize() * r.c.textScale
+	r.text.Text = r.c.text
+	r.text.TextSize = size
+	r.text.Color = fg
+	r.text.Refresh()
+	r.del.TextSize = size
+	r.del.Color = fg
+	r.del.Hidden = r.c.OnDeleted == nil
+	r.del.Refresh()
+	r.icon.SetResource(r.c.icon)
+	r.icon.Hidden = r.c.icon == nil
+	r.bg.FillColor = getColor(r.c.bgColor)
+	r.bg.Refresh()
+	r.Layout(r.c.Size())
+}
+
+// WidgetRenderer interface
+func (r *colorChipRenderer) Destroy() {
+}
+
+// WidgetRenderer interface
+func (r *colorChipRenderer) Objects() []fyne.CanvasObject {
+	return r.objs
+}
@@ -33,13 +33,20 @@ package colorlabel
 
 import (
 	"errors"
+	"fmt"
 	"image/color"
+	"net/url"
+	"slices"
+	"strings"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/canvas"
 	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/driver/mobile"
 	"fyne.io/fyne/v2/theme"
 	"fyne.io/fyne/v2/widget"
+	"github.com/go-text/typesetting/segmenter"
+	"golang.org/x/text/language"
 )
 
 var (
@@ -48,6 +55,10 @@ var (
 	_ fyne.DoubleTappable    = (*ColorLabel)(nil)
 	_ fyne.SecondaryTappable = (*ColorLabel)(nil)
 	_ desktop.Mouseable      = (*ColorLabel)(nil)
+	_ desktop.Hoverable      = (*ColorLabel)(nil)
+	_ mobile.Touchable       = (*ColorLabel)(nil)
+	_ desktop.Cursorable     = (*ColorLabel)(nil)
+	_ fyne.Disableable       = (*ColorLabel)(nil)
 	_ fyne.WidgetRenderer    = (*ColorLabelRenderer)(nil)
 )
 
@@ -58,6 +69,10 @@ var (
 //   - fyne.DoubleTappable
 //	 - fyne.SecondaryTappable
 //   - desktop.Mouseable
+//   - desktop.Hoverable
+//   - mobile.Touchable
+//   - desktop.Cursorable
+//   - fyne.Disableable
 
 type TruncateModeType int
 
@@ -65,8 +80,13 @@ const (
 	None TruncateModeType = iota
 	End
 	Begin
+	Middle
+	// Truncation at the end done by fyne itself like widget.Label
+	Native
 )
 
+const defaultEllipsis = "…"
+
 type ColorLabel struct {
 	widget.BaseWidget
 
@@ -83,64 +103,248 @@ type ColorLabel struct {
 	OnTappedSecondaryEx func(*fyne.PointEvent)
 	OnDoubleTapped      func()
 	OnDoubleTappedEx    func(*fyne.PointEvent)
+	OnEscalated         func()
 	lastKeyModifier     fyne.KeyModifier
 	alignment           fyne.TextAlign
+	importance          widget.Importance
+
+	// Callbacks with the tap position and the keyboard modifiers pressed while tapping
+	OnTappedMod          func(*fyne.PointEvent, fyne.KeyModifier)
+	OnTappedSecondaryMod func(*fyne.PointEvent, fyne.KeyModifier)
+	OnDoubleTappedMod    func(*fyne.PointEvent, fyne.KeyModifier)
+
+	// Called if a touch is held for the long press delay, on touch devices only
+	OnLongPressed func(*fyne.PointEvent)
+	// Called if the selected state changes
+	OnSelectionChanged func(selected bool)
+	// Called with the missing width if the text was truncated to fit into the label
+	OnOverflow func(excessWidth float32)
+	// Called with the number of hidden lines if the wrapped text needs more lines
+	// than set by SetMaxLines
+	OnLinesOverflow func(hiddenLines int)
+	// Called if a link segment is tapped, if nil the link is opened
+	OnLinkTapped func(link *url.URL)
+	// Called when a color was chosen by color picking
+	OnColorChanged func(target ColorTargetType, c color.Color)
+	// Called after text or style properties changed, once per refresh,
+	// change is a combination of ChangeText and ChangeStyle
+	OnChanged func(change ChangeType)
+	// Selects text and background color for the value of UpdateValue,
+	// nil keeps the color. Has precedence over SetValueRules.
+	ConditionalColor func(v float64) (txtColor, backColor any)
+
+	tappedAction          string
+	tappedSecondaryAction string
+	doubleTappedAction    string
+
+	hover hoverState
+	caret caretState
+
+	mirrors   []mirrorTarget
+	mirroring bool
+
+	renderedText string
+	truncated    bool
+
+	escalation escalationState
+
+	ellipsis string
+
+	lastOverflow  float32
+	sizeToContent bool
+	transform     TextTransformType
+	locale        language.Tag
+	widthLimit    float32
+	padding       *Padding
+	margins       Padding
+	static        bool
+	colorMeaning  string
+	// show the color meaning in the tool tip
+	meaningToolTip bool
+	longPress      longPressState
+	contextMenu    *fyne.Menu
+	pressed        bool
+	disabled       bool
+	selected       bool
+	stateStyles    map[StateType]Style
+	borderColor    any
+	borderWidth    float32
+	class          string
+	selectable     bool
+	group          *ColorLabelGroup
+
+	flash    flashState
+	value    valueState
+	fade     fadeState
+	loading  loadingState
+	pulse    pulseState
+	progress progressState
+	accent   accentState
+	pattern  patternState
+	bgImage  bgImageState
+	outline  outlineState
+
+	pickTarget ColorTargetType
+
+	segments    []Segment
+	segmentsGen int
+	links       []linkArea
+
+	// text before highlighting, nil if nothing is highlighted
+	highlightBase []Segment
+	rules         ruleState
+	bind          bindState
+	batch         batchState
+	snapshot      changeSnapshot
+	placeholder   placeholderState
+	mask          maskState
+	affix         affixState
+	tmpl          templateState
+	wrap          wrapState
+	orientation   OrientationType
+	tabWidth      int
+	lineSpacing   float32
+	letterSpacing float32
+	// rendered to an image only, animations are not started
+	offscreen bool
 }
 
-func getColor(c any) color.Color {
-	switch v := any(c).(type) {
+// Returned if a color of an unsupported type is used
+var ErrUnsupportedColor = errors.New("fyne.ThemeColorName or color.NRGBA required")
+
+// Resolves a color spec like it is used by ColorLabel
+// spec is NRGBA, fyne.ThemeColorName or a theme color name as string
+// Names which are no known theme color names are looked up as CSS named colors.
+// th is the theme for resolving color names, if nil the current theme is used
+// variant is the theme variant for resolving color names
+func ResolveColor(spec any, th fyne.Theme, variant fyne.ThemeVariant) (color.Color, error) {
+	if th == nil {
+		th = theme.Current()
+	}
+	switch v := spec.(type) {
 	case string:
-		return theme.Color(fyne.ThemeColorName(v))
+		return resolveColorName(fyne.ThemeColorName(v), th, variant), nil
 	case fyne.ThemeColorName:
-		return theme.Color(v)
+		return resolveColorName(v, th, variant), nil
 	case color.NRGBA:
-		return v
+		return v, nil
+	case AdaptiveColor:
+		return v.resolve(variant), v.validate()
 	case color.Alpha16:
-		return v
+		return v, nil
 	case color.Gray16:
-		return v
+		return v, nil
+	}
+	return color.Transparent, ErrUnsupportedColor
+}
+
+func resolveColorName(name fyne.ThemeColorName, th fyne.Theme, variant fyne.ThemeVariant) color.Color {
+	if !IsKnownThemeColorName(name) {
+		if c, ok := CSSColor(string(name)); ok {
+			return c
+		}
+	}
+	return th.Color(name, variant)
+}
+
+func currentVariant() fyne.ThemeVariant {
+	if a := fyne.CurrentApp(); a != nil {
+		return a.Settings().ThemeVariant()
+	}
+	return theme.VariantDark
+}
+
+func getColor(c any) color.Color {
+	col, _ := ResolveColor(c, theme.Current(), currentVariant())
+	return col
+}
+
+// Checks if c is a supported color
+// Supported are nil (default color), NRGBA, fyne.ThemeColorName and theme color names as string,
+// CSS named colors like "tomato" are accepted as color names too,
+// AdaptiveColor is supported for colors following the theme variant
+// Unknown theme color names are logged if enabled by SetThemeNameValidation
+func ValidateColor(c any) error {
+	switch c.(type) {
+	case nil, color.NRGBA, color.Alpha16, color.Gray16:
+		return nil
+	case string, fyne.ThemeColorName:
+		checkThemeName(c)
+		return nil
+	case AdaptiveColor:
+		return c.(AdaptiveColor).validate()
+	}
+	return ErrUnsupportedColor
+}
+
+func isDefaultColor(c any) bool {
+	switch v := c.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case fyne.ThemeColorName:
+		return v == ""
 	}
-	return color.Transparent
+	return false
+}
+
+func normalizeTextColor(c any) (any, error) {
+	if isDefaultColor(c) {
+		return theme.ColorNameForeground, nil
+	}
+	return c, ValidateColor(c)
+}
+
+func normalizeBackgroundColor(c any) (any, error) {
+	if isDefaultColor(c) {
+		return color.Transparent, nil
+	}
+	return c, ValidateColor(c)
+}
+
+// Initializes the defaults of a ColorLabel embedded in another widget
+func (l *ColorLabel) initEmbedded() {
+	l.fgColor = theme.ColorNameForeground
+	l.bgColor = color.Transparent
+	l.textScale = 1
+	l.textStyle = &fyne.TextStyle{}
+	l.alignment = fyne.TextAlignLeading
+	l.snapshot = l.takeSnapshot()
 }
 
 // Creates a new ColorLabel
 // txtColor is NRGBA or fyne.ThemeColorName
 // backColor is NRGBA or fyne.ThemeColorName
+// Returns nil if a color is not supported, use NewColorLabelWithError for getting the reason
 func NewColorLabel(s string, txtColor, backColor any, tScale float32) *ColorLabel {
-	if backColor == nil {
-		backColor = ""
-	}
-	switch c := any(backColor).(type) {
-	case fyne.ThemeColorName, string:
-		if c == "" {
-			backColor = color.Transparent
-		}
-	case color.NRGBA:
-		backColor = c
-	case color.Alpha16:
-		backColor = c
-	case color.Gray16:
-		backColor = c
-	default:
+	l, err := NewColorLabelWithError(s, txtColor, backColor, tScale)
+	if err != nil {
 		return nil
 	}
+	return l
+}
 
-	if txtColor == nil {
-		txtColor = ""
+// Creates a new ColorLabel with text formatted like fmt.Sprintf
+// txtColor is NRGBA or fyne.ThemeColorName
+// backColor is NRGBA or fyne.ThemeColorName
+// Returns nil if a color is not supported
+func NewColorLabelf(txtColor, backColor any, tScale float32, format string, args ...any) *ColorLabel {
+	return NewColorLabel(fmt.Sprintf(format, args...), txtColor, backColor, tScale)
+}
+
+// Creates a new ColorLabel
+// txtColor is NRGBA or fyne.ThemeColorName
+// backColor is NRGBA or fyne.ThemeColorName
+// Returns ErrUnsupportedColor if a color is not supported
+func NewColorLabelWithError(s string, txtColor, backColor any, tScale float32) (*ColorLabel, error) {
+	backColor, err := normalizeBackgroundColor(backColor)
+	if err != nil {
+		return nil, err
 	}
-	switch c := any(txtColor).(type) {
-	case fyne.ThemeColorName, string:
-		if c == "" {
-			txtColor = theme.ColorNameForeground
-		}
-	case color.NRGBA:
-		txtColor = c
-	case color.Alpha16:
-		txtColor = c
-	case color.Gray16:
-		txtColor = c
-	default:
-		return nil
+	txtColor, err = normalizeTextColor(txtColor)
+	if err != nil {
+		return nil, err
 	}
 
 	if tScale <= 0 {
@@ -157,6 +361,7 @@ func NewColorLabel(s string, txtColor, backColor any, tScale float32) *ColorLabe
 	}
 
 	colorLabel.ExtendBaseWidget(colorLabel)
+	colorLabel.snapshot = colorLabel.takeSnapshot()
 
 	/*
 
@@ -166,118 +371,487 @@ func NewColorLabel(s string, txtColor, backColor any, tScale float32) *ColorLabe
 			colorLabel.Refresh()
 		})
 	*/
-	return colorLabel
+	return colorLabel, nil
 }
 
 // Widget interface
 func (l *ColorLabel) CreateRenderer() fyne.WidgetRenderer {
-	t := canvas.NewText(l.fullText, getColor(l.fgColor))
-	b := canvas.NewRectangle(getColor(l.bgColor))
-	return &ColorLabelRenderer{
-		w:    l,
-		text: t,
-		bg:   b,
-		objs: []fyne.CanvasObject{b, t},
+	fg, bg := l.stateColors()
+	t := canvas.NewText(l.fullText, l.renderColor(fg))
+	b := canvas.NewRectangle(l.renderColor(bg))
+	c := newCaret()
+	r := &ColorLabelRenderer{
+		w:      l,
+		text:   t,
+		bg:     b,
+		caret:  c,
+		source: l.displayText(),
+		objs:   []fyne.CanvasObject{b, t, c},
 	}
+	r.updateBackground()
+	return r
+}
+
+// Widget interface
+// Refreshes the label and all labels it is mirrored to
+// Inside BeginUpdate and EndUpdate the refresh is deferred
+// OnChanged is called if text or style properties changed
+func (l *ColorLabel) Refresh() {
+	if l.batch.depth > 0 {
+		l.batch.pending = true
+		return
+	}
+	l.refreshMirrors()
+	l.BaseWidget.Refresh()
+	l.notifyChanged()
 }
 
 // ColorLabelRenderer implements:
 //   - fyne.WidgetRenderer
 type ColorLabelRenderer struct {
-	w        *ColorLabel
-	text     *canvas.Text
-	bg       *canvas.Rectangle
-	objs     []fyne.CanvasObject
-	maxWidth float32
+	w         *ColorLabel
+	text      *canvas.Text
+	bg        *canvas.Rectangle
+	caret     *canvas.Rectangle
+	caretAnim *fyne.Animation
+	native    *nativeText
+	segs      *segmentView
+	wrap      *wrapView
+	letters   *lettersView
+	loading   *loadingView
+	progress  *canvas.Rectangle
+	accent    *canvas.Rectangle
+	pattern   *patternView
+	bgImage   *bgImageView
+	outline   []*canvas.Text
+	source    string
+	objs      []fyne.CanvasObject
+	maxWidth  float32
+
+	measured      measureKey
+	measuredText  truncation
+	measuredValid bool
+
+	staticSize fyne.Size
+	staticDone bool
+
+	rot           *rotatedView
+	rotatedLayout bool
+}
+
+// Everything the truncated text depends on, so unchanged text is not measured again
+type measureKey struct {
+	text     string
+	size     float32
+	style    fyne.TextStyle
+	width    float32
+	truncate TruncateModeType
+	ellipsis string
 }
 
 // WidgetRenderer interface
 func (r *ColorLabelRenderer) Layout(size fyne.Size) {
-	pad := theme.Padding()
-	s := fyne.NewSize(size.Width-2*pad, size.Height-2*pad)
-	s2 := fyne.NewSize(size.Width, size.Height)
-	p := fyne.NewPos(pad, pad)
-	p2 := fyne.NewPos(0, 0)
+	r.rotatedLayout = r.w.rotated()
+	if r.rotatedLayout {
+		r.layoutFlat(transposeSize(size))
+		r.renderRotated(size)
+		return
+	}
+	r.layoutFlat(size)
+}
+
+// Lays out the objects for horizontal text
+func (r *ColorLabelRenderer) layoutFlat(size fyne.Size) {
+	if r.w.static && r.staticSize == size {
+		return
+	}
+	r.staticSize = size
+	pad := r.w.contentPadding()
 	r.maxWidth = size.Width
+	if r.w.widthLimit > 0 {
+		r.maxWidth = min(size.Width, r.w.widthLimit)
+	}
+	s := fyne.NewSize(r.maxWidth-pad.Left-pad.Right, size.Height-pad.Top-pad.Bottom)
+	m := r.w.margins
+	s2 := fyne.NewSize(size.Width-m.Left-m.Right, size.Height-m.Top-m.Bottom)
+	p := fyne.NewPos(pad.Left, pad.Top)
+	p2 := fyne.NewPos(m.Left, m.Top)
 
 	r.text.Resize(s)
 	r.bg.Resize(s2)
 	r.text.Move(p)
 	r.bg.Move(p2)
+	r.layoutBackgroundImage()
+	r.layoutPattern()
+	r.layoutProgress()
+	r.layoutAccent()
+	if r.native != nil {
+		r.native.wrap.Resize(s)
+		r.native.wrap.Move(p)
+	}
+	if r.segs != nil {
+		r.segs.box.Resize(s)
+		r.segs.box.Move(p)
+	}
+	if r.wrap != nil {
+		r.wrap.box.Resize(s)
+		r.wrap.box.Move(p)
+	}
+	if r.letters != nil {
+		r.letters.box.Resize(s)
+		r.letters.box.Move(p)
+	}
 	r.setTextProperties()
 	r.text.Refresh()
+	r.layoutOutline()
+	r.layoutCaret()
+	r.checkOverflow()
+}
+
+// Returns the width available for the text
+func (r *ColorLabelRenderer) textWidth() float32 {
+	pad := r.w.contentPadding()
+	return r.maxWidth - pad.Left - pad.Right
+}
+
+// Calls OnOverflow if the text was truncated to fit into the available width
+func (r *ColorLabelRenderer) checkOverflow() {
+	if r.w.OnOverflow == nil || r.maxWidth <= 0 {
+		return
+	}
+	excess := fyne.MeasureText(r.source, r.text.TextSize, r.text.TextStyle).Width - r.textWidth()
+	if excess <= 0 || !r.w.truncated {
+		r.w.lastOverflow = 0
+		return
+	}
+	if excess != r.w.lastOverflow {
+		r.w.lastOverflow = excess
+		f := r.w.OnOverflow
+		fyne.Do(func() {
+			f(excess)
+		})
+	}
 }
 
 func (r *ColorLabelRenderer) setTextProperties() {
-	r.text.Text = r.w.truncateText(r.w.fullText, r.maxWidth, r.text)
 	r.text.TextSize = theme.TextSize() * r.w.textScale
 	r.text.TextStyle = *r.w.textStyle
+	// 0 keeps the fyne default of 4 spaces
+	r.text.TextStyle.TabWidth = r.w.tabWidth
 	r.text.Alignment = r.w.alignment
-	r.text.Text = r.w.truncateText(r.w.fullText, r.maxWidth, r.text)
-	r.text.Color = getColor(r.w.fgColor)
+	if r.w.showsPlaceholder() {
+		r.text.TextSize = theme.TextSize() * r.w.placeholderTextScale()
+		r.text.TextStyle = r.w.placeholderTextStyle()
+	}
+	r.source = r.w.displayText()
+	if r.w.loading.on {
+		r.showLoading()
+		return
+	}
+	r.hideLoading()
+	fg, _ := r.w.stateColors()
+	if segs := r.w.activeSegments(); len(segs) > 0 {
+		r.hideWrapped()
+		r.hideSpaced()
+		r.setSegmentProperties(r.w.renderColor(fg), segs)
+		return
+	}
+	r.hideSegments()
+	if r.w.wraps() {
+		r.hideSpaced()
+		r.setWrappedTextProperties(r.w.renderColor(fg))
+		return
+	}
+	r.hideWrapped()
+	if r.w.letterSpacing != 0 {
+		r.setSpacedTextProperties(r.w.renderColor(fg))
+		return
+	}
+	r.hideSpaced()
+	if r.w.truncateMode() == Native {
+		r.setNativeTextProperties(r.w.renderColor(fg))
+		return
+	}
+	if r.native != nil {
+		r.native.wrap.Hide()
+		r.text.Show()
+	}
+	key := measureKey{
+		text:     r.source,
+		size:     r.text.TextSize,
+		style:    r.text.TextStyle,
+		width:    r.textWidth(),
+		truncate: r.w.truncateMode(),
+		ellipsis: r.w.ellipsis,
+	}
+	if !r.measuredValid || r.measured != key {
+		r.measuredText = r.w.truncateText(r.source, r.textWidth(), r.text)
+		r.measured = key
+		r.measuredValid = true
+	}
+	r.text.Text = r.measuredText.text
+	r.w.renderedText = r.measuredText.text
+	r.w.truncated = r.measuredText.text != r.source
+	r.text.Color = r.w.renderColor(fg)
 	r.text.Refresh()
 }
 
+func (r *ColorLabelRenderer) setNativeTextProperties(fg color.Color) {
+	if r.native == nil {
+		r.native = newNativeText()
+		r.native.wrap.Resize(r.bg.Size())
+		// insert in front of the caret
+		r.objs = append(r.objs[:len(r.objs)-1], r.native.wrap, r.caret)
+	}
+	r.text.Hide()
+	r.text.Text = r.source
+	r.text.Color = fg
+	r.measuredValid = false
+	r.w.renderedText = r.source
+	r.w.truncated = r.updateNative(fg)
+	r.measuredText = truncation{text: r.source}
+	if !r.w.truncated {
+		r.measuredText.head = len([]rune(r.source))
+	}
+}
+
 // WidgetRenderer interface
 func (r *ColorLabelRenderer) MinSize() fyne.Size {
-	h := r.text.MinSize().Height + 2*theme.Padding()
-	w := r.text.MinSize().Width + 2*theme.Padding()
-	return fyne.NewSize(w, h)
+	if r.w.rotated() {
+		return transposeSize(r.minSizeFlat())
+	}
+	return r.minSizeFlat()
+}
+
+// Returns the minimum size for horizontal text
+func (r *ColorLabelRenderer) minSizeFlat() fyne.Size {
+	pad := r.w.contentPadding()
+	padSize := fyne.NewSize(pad.Left+pad.Right, pad.Top+pad.Bottom)
+	if r.w.loading.on && r.loading != nil {
+		return r.w.limitSize(fyne.NewSize(r.loadingWidth(), r.text.MinSize().Height).Add(padSize))
+	}
+	if r.w.wraps() && r.wrap != nil {
+		return r.w.limitSize(r.wrappedMinSize().Add(padSize))
+	}
+	if r.w.letterSpacing != 0 && r.letters != nil && len(r.w.activeSegments()) == 0 {
+		return r.w.limitSize(r.spacedMinSize().Add(padSize))
+	}
+	if r.w.sizeToContent {
+		full := fyne.MeasureText(r.source, r.text.TextSize, r.text.TextStyle)
+		return r.w.limitSize(full.Add(padSize))
+	}
+	if len(r.w.activeSegments()) > 0 && r.segs != nil {
+		return r.w.limitSize(fyne.NewSize(r.segs.width, r.segs.height).Add(padSize))
+	}
+	if r.w.truncateMode() == Native && r.native != nil {
+		return r.w.limitSize(r.native.wrap.MinSize().Add(padSize))
+	}
+	return r.w.limitSize(r.text.MinSize().Add(padSize))
 }
 
 // WidgetRenderer interface
 func (r *ColorLabelRenderer) Refresh() {
+	if r.rotatedLayout != r.w.rotated() {
+		// the objects are laid out for the other orientation
+		r.Layout(r.w.Size())
+	}
+	r.refreshFlat()
+	if r.rotatedLayout {
+		r.renderRotated(r.w.Size())
+	}
+}
+
+// Updates the objects for horizontal text
+func (r *ColorLabelRenderer) refreshFlat() {
+	if r.w.static && r.staticDone && r.w.loading.on == r.loadingShown() {
+		// only colors may change with the theme
+		fg, _ := r.w.stateColors()
+		r.text.Color = r.w.renderColor(fg)
+		r.text.Refresh()
+		if r.w.wraps() && r.wrap != nil {
+			for _, t := range r.wrap.lines {
+				t.Color = r.text.Color
+				t.Refresh()
+			}
+		}
+		if r.letters != nil {
+			for _, t := range r.letters.letters {
+				t.Color = r.text.Color
+				t.Refresh()
+			}
+		}
+		if len(r.w.activeSegments()) > 0 && r.segs != nil {
+			r.buildSegments(r.w.renderColor(fg))
+		}
+		r.updateBackground()
+		r.layoutOutline()
+		return
+	}
+	r.staticDone = r.w.static
 	r.setTextProperties()
+	r.layoutOutline()
+	r.updateBackground()
+	r.layoutCaret()
+	r.checkOverflow()
+}
 
-	r.bg.FillColor = getColor(r.w.bgColor)
+// Sets fill and border of the background from the current state
+func (r *ColorLabelRenderer) updateBackground() {
+	s := r.w.stateStyle()
+	r.bg.FillColor = r.w.fadeColor(r.w.flashOverlay(r.w.pulseOverlay(getColor(r.w.progressBackground(s.BackgroundColor)))))
+	if s.BorderWidth > 0 && s.BorderColor != nil {
+		r.bg.StrokeColor = r.w.renderColor(s.BorderColor)
+		r.bg.StrokeWidth = s.BorderWidth
+	} else {
+		r.bg.StrokeWidth = 0
+	}
 	r.bg.Refresh()
+	r.layoutBackgroundImage()
+	r.layoutPattern()
+	r.layoutProgress()
+	r.layoutAccent()
 }
 
 // WidgetRenderer interface
 func (r *ColorLabelRenderer) Destroy() {
+	r.w.stopHoverTimer()
+	r.w.hideToolTip()
+	r.w.stopLongPress()
+	r.stopCaretAnimation()
+	r.stopLoadingAnimation()
+	r.w.stopPulse()
 }
 
 func (r *ColorLabelRenderer) Objects() []fyne.CanvasObject {
+	if r.rotatedLayout && r.rot != nil {
+		return r.rot.objs
+	}
 	return r.objs
 }
 
+// Rebuilds the objects behind the text in the order
+// background, image, scrim, pattern, progress, accent and text outline
+func (r *ColorLabelRenderer) updateBackgroundObjects() {
+	objs := []fyne.CanvasObject{r.bg}
+	if r.bgImage != nil {
+		objs = append(objs, r.bgImage.img, r.bgImage.tile, r.bgImage.scrim)
+	}
+	if r.pattern != nil {
+		objs = append(objs, r.pattern.raster)
+	}
+	if r.progress != nil {
+		objs = append(objs, r.progress)
+	}
+	if r.accent != nil {
+		objs = append(objs, r.accent)
+	}
+	for _, t := range r.outline {
+		objs = append(objs, t)
+	}
+	i := slices.Index(r.objs, fyne.CanvasObject(r.text))
+	r.objs = append(objs, r.objs[i:]...)
+}
+
 // Tappable interface
 func (l *ColorLabel) Tapped(ev *fyne.PointEvent) {
+	if l.disabled {
+		return
+	}
+	if l.tapLink(ev) || l.pickColor() {
+		return
+	}
+	if l.group != nil {
+		l.group.tapped(l)
+	} else if l.selectable {
+		l.SetSelected(!l.selected)
+	}
 	if l.OnTapped != nil {
 		l.OnTapped()
 	}
 	if l.OnTappedEx != nil {
 		l.OnTappedEx(ev)
 	}
+	if l.OnTappedMod != nil {
+		l.OnTappedMod(ev, l.currentKeyModifier())
+	}
 }
 
 // SecondaryTappable interface
 func (l *ColorLabel) TappedSecondary(ev *fyne.PointEvent) {
+	if l.disabled {
+		return
+	}
+	if l.consumeLongPress() {
+		return
+	}
 	if l.OnTappedSecondary != nil {
 		l.OnTappedSecondary()
 	}
 	if l.OnTappedSecondaryEx != nil {
 		l.OnTappedSecondaryEx(ev)
 	}
+	if l.OnTappedSecondaryMod != nil {
+		l.OnTappedSecondaryMod(ev, l.currentKeyModifier())
+	}
+	l.showContextMenu(ev)
 }
 
 // DoubleTappable interface
 func (l *ColorLabel) DoubleTapped(ev *fyne.PointEvent) {
+	if l.disabled {
+		return
+	}
 	if l.OnDoubleTapped != nil {
 		l.OnDoubleTapped()
 	}
 	if l.OnDoubleTappedEx != nil {
 		l.OnDoubleTappedEx(ev)
 	}
+	if l.OnDoubleTappedMod != nil {
+		l.OnDoubleTappedMod(ev, l.currentKeyModifier())
+	}
+}
+
+// Cursorable interface
+// Shows the pointer cursor if the label reacts on taps
+func (l *ColorLabel) Cursor() desktop.Cursor {
+	if l.isTappable() {
+		return desktop.PointerCursor
+	}
+	return desktop.DefaultCursor
+}
+
+// Reports if a primary tap or double tap does something
+func (l *ColorLabel) isTappable() bool {
+	if l.disabled {
+		return false
+	}
+	return l.selectable || l.group != nil || l.OnTapped != nil || l.OnTappedEx != nil || l.OnTappedMod != nil ||
+		l.OnDoubleTapped != nil || l.OnDoubleTappedEx != nil || l.OnDoubleTappedMod != nil || l.hasLinks() ||
+		l.pickTarget != ColorTargetNone
+}
+
+// Returns the keyboard modifiers pressed right now if the driver supports it,
+// otherwise the modifiers of the last mouse event
+func (l *ColorLabel) currentKeyModifier() fyne.KeyModifier {
+	if a := fyne.CurrentApp(); a != nil {
+		if d, ok := a.Driver().(desktop.Driver); ok {
+			return d.CurrentKeyModifiers()
+		}
+	}
+	return l.lastKeyModifier
 }
 
 // Mouseable interface
 func (l *ColorLabel) MouseDown(ev *desktop.MouseEvent) {
+	l.lastKeyModifier = ev.Modifier
+	l.setPressed(true)
 }
 
 // Mouseable interface
 func (l *ColorLabel) MouseUp(ev *desktop.MouseEvent) {
 	l.lastKeyModifier = ev.Modifier
+	l.setPressed(false)
 }
 
 // User functions
@@ -288,68 +862,100 @@ func (l *ColorLabel) GetLastKeyModifier() fyne.KeyModifier {
 
 // Set new text
 func (l *ColorLabel) SetText(s string) {
-	if l.fullText != s {
+	l.clearTemplate()
+	if l.fullText != s || l.segments != nil {
 		l.fullText = s
+		l.segments = nil
+		l.highlightBase = nil
 		l.Refresh()
 	}
 }
 
+// Set new text formatted like fmt.Sprintf
+func (l *ColorLabel) SetTextf(format string, args ...any) {
+	l.SetText(fmt.Sprintf(format, args...))
+}
+
 func (l *ColorLabel) GetText() string {
 	return l.fullText
 }
 
-func (l *ColorLabel) truncateText(s string, maxWidth float32, text *canvas.Text) string {
-	if l.truncate == None {
-		return s
+// Get the text as it is currently displayed, e.g. truncated with ellipsis
+// Before the label is rendered and in Native truncate mode the full text is returned
+func (l *ColorLabel) RenderedText() string {
+	if l.renderedText == "" && !l.truncated {
+		return l.displayText()
 	}
-	maxWidth -= theme.Padding() * 2
-	ellipsis := "…"
-	ellW := fyne.MeasureText(ellipsis, text.TextSize, text.TextStyle).Width
+	return l.renderedText
+}
+
+// Reports if the last layout truncated the text
+func (l *ColorLabel) IsTruncated() bool {
+	return l.truncated
+}
+
+// Result of truncating a text
+// head and tail are the number of runes kept from the begin and end of the full text
+type truncation struct {
+	text string
+	head int
+	tail int
+}
+
+// maxWidth is the width available for the text without padding
+func (l *ColorLabel) truncateText(s string, maxWidth float32, text *canvas.Text) truncation {
+	all := truncation{text: s, head: len([]rune(s))}
+	mode := l.truncateMode()
+	if mode == None {
+		return all
+	}
+	ell := l.GetEllipsis()
+	ellW := fyne.MeasureText(ell, text.TextSize, text.TextStyle).Width
 
-	r := []rune(s)
 	if fyne.MeasureText(s, text.TextSize, text.TextStyle).Width <= maxWidth {
-		return s
+		return all
 	}
 
-	for len(r) > 0 {
-		switch l.truncate {
+	// cut whole grapheme clusters, so emoji sequences and combining characters stay intact
+	g := graphemes(s)
+	for keep := len(g) - 1; keep > 0; keep-- {
+		var head, tail []string
+		switch mode {
 		case End:
-			r = r[:len(r)-1]
+			head = g[:keep]
 		case Begin:
-			r = r[1:]
+			tail = g[len(g)-keep:]
+		case Middle:
+			head = g[:(keep+1)/2]
+			tail = g[len(g)-keep/2:]
 		}
-
-		if fyne.MeasureText(string(r), text.TextSize, text.TextStyle).Width+ellW <= maxWidth {
-			switch l.truncate {
-			case End:
-				return string(r) + ellipsis
-			case Begin:
-				return ellipsis + string(r)
-			}
+		h := strings.Join(head, "")
+		t := strings.Join(tail, "")
+		if fyne.MeasureText(h+t, text.TextSize, text.TextStyle).Width+ellW <= maxWidth {
+			return truncation{text: h + ell + t, head: len([]rune(h)), tail: len([]rune(t))}
 		}
 	}
-	return ellipsis
+	return truncation{text: ell}
+}
+
+// Splits s into grapheme clusters
+func graphemes(s string) []string {
+	var seg segmenter.Segmenter
+	seg.InitWithString(s)
+	var g []string
+	iter := seg.GraphemeIterator()
+	for iter.Next() {
+		g = append(g, string(iter.Grapheme().Text))
+	}
+	return g
 }
 
 // Set new text color
 // txtColor is NRGBA or fyne.ThemeColorName
 func (l *ColorLabel) SetTextColor(txtColor any) error {
-	if txtColor == nil {
-		txtColor = ""
-	}
-	switch c := txtColor.(type) {
-	case fyne.ThemeColorName, string:
-		if c == "" {
-			txtColor = theme.ColorNameForeground
-		}
-	case color.NRGBA:
-		txtColor = c
-	case color.Alpha16:
-		txtColor = c
-	case color.Gray16:
-		txtColor = c
-	default:
-		return errors.New("fyne.ThemeColorName or color.NRGBA required")
+	txtColor, err := normalizeTextColor(txtColor)
+	if err != nil {
+		return err
 	}
 	if l.fgColor != txtColor {
 		l.fgColor = txtColor
@@ -361,22 +967,9 @@ func (l *ColorLabel) SetTextColor(txtColor any) error {
 // Set new background color
 // backColor is NRGBA or fyne.ThemeColorName
 func (l *ColorLabel) SetBackgroundColor(backColor any) error {
-	if backColor == nil {
-		backColor = ""
-	}
-	switch c := backColor.(type) {
-	case fyne.ThemeColorName, string:
-		if c == "" {
-			backColor = color.Transparent
-		}
-	case color.NRGBA:
-		backColor = c
-	case color.Alpha16:
-		backColor = c
-	case color.Gray16:
-		backColor = c
-	default:
-		return errors.New("fyne.ThemeColorName or color.NRGBA required")
+	backColor, err := normalizeBackgroundColor(backColor)
+	if err != nil {
+		return err
 	}
 	if l.bgColor != backColor {
 		l.bgColor = backColor
@@ -385,6 +978,26 @@ func (l *ColorLabel) SetBackgroundColor(backColor any) error {
 	return nil
 }
 
+// Set text and background color with only one refresh
+// txtColor and backColor are NRGBA or fyne.ThemeColorName
+// If one of the colors is invalid, none is changed
+func (l *ColorLabel) SetColors(txtColor, backColor any) error {
+	txtColor, err := normalizeTextColor(txtColor)
+	if err != nil {
+		return err
+	}
+	backColor, err = normalizeBackgroundColor(backColor)
+	if err != nil {
+		return err
+	}
+	if l.fgColor != txtColor || l.bgColor != backColor {
+		l.fgColor = txtColor
+		l.bgColor = backColor
+		l.Refresh()
+	}
+	return nil
+}
+
 // Set new text scale factor
 func (l *ColorLabel) SetTextScale(tScale float32) {
 	if tScale <= 0 {
@@ -427,6 +1040,77 @@ func (l *ColorLabel) SetTruncateMode(tr TruncateModeType) {
 	}
 }
 
+// Asserts that the label does not change after creation
+// A static label skips truncation checks on refresh, ignores hovering
+// and only updates its colors on theme changes
+func (l *ColorLabel) SetStatic(static bool) {
+	if l.static != static {
+		l.static = static
+		if static {
+			l.stopHoverTimer()
+			l.hideToolTip()
+			l.hover.hovered = false
+		}
+		l.Refresh()
+	}
+}
+
+// Set the maximum width the label requests, longer text is truncated
+// Values <= 0 remove the limit
+func (l *ColorLabel) SetMaxWidth(w float32) {
+	if l.widthLimit != w {
+		l.widthLimit = w
+		l.Refresh()
+	}
+}
+
+// Get the maximum width, 0 if there is no limit
+func (l *ColorLabel) GetMaxWidth() float32 {
+	return max(l.widthLimit, 0)
+}
+
+// Returns the truncate mode in effect
+// With a maximum width text is truncated at the end if no mode is set
+func (l *ColorLabel) truncateMode() TruncateModeType {
+	if l.truncate == None && l.widthLimit > 0 {
+		return End
+	}
+	return l.truncate
+}
+
+func (l *ColorLabel) limitSize(s fyne.Size) fyne.Size {
+	if l.widthLimit > 0 {
+		s.Width = min(s.Width, l.widthLimit)
+	}
+	return s
+}
+
+// If true the minimum size includes the width of the full text,
+// so the label does not collapse inside boxes even if truncation is enabled
+func (l *ColorLabel) SetSizeToContent(b bool) {
+	if l.sizeToContent != b {
+		l.sizeToContent = b
+		l.Refresh()
+	}
+}
+
+// Set the marker used for truncated text, e.g. " [more]"
+// An empty string restores the default "…"
+func (l *ColorLabel) SetEllipsis(s string) {
+	if l.ellipsis != s {
+		l.ellipsis = s
+		l.Refresh()
+	}
+}
+
+// Get the marker used for truncated text
+func (l *ColorLabel) GetEllipsis() string {
+	if l.ellipsis == "" {
+		return defaultEllipsis
+	}
+	return l.ellipsis
+}
+
 func (l *ColorLabel) SetAlinment(align fyne.TextAlign) {
 	l.alignment = align
 	l.Refresh()
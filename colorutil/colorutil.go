@@ -0,0 +1,160 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Package colorutil contains the color math used by the colorlabel widgets,
+// so apps can derive hover, pressed or disabled shades the same way.
+
+package colorutil
+
+import (
+	"image/color"
+	"math"
+)
+
+// Composites src over dst (source over alpha blending)
+func Over(dst, src color.Color) color.NRGBA {
+	d := toNRGBA(dst)
+	s := toNRGBA(src)
+	sa := float64(s.A) / 255
+	da := float64(d.A) / 255
+	oa := sa + da*(1-sa)
+	if oa == 0 {
+		return color.NRGBA{}
+	}
+	mix := func(sc, dc uint8) uint8 {
+		return uint8((float64(sc)*sa + float64(dc)*da*(1-sa)) / oa)
+	}
+	return color.NRGBA{R: mix(s.R, d.R), G: mix(s.G, d.G), B: mix(s.B, d.B), A: uint8(oa*255 + 0.5)}
+}
+
+// Linear mix of a and b, f = 0 returns a and f = 1 returns b
+func Mix(a, b color.Color, f float64) color.NRGBA {
+	x := toNRGBA(a)
+	y := toNRGBA(b)
+	mix := func(p, q uint8) uint8 {
+		return uint8(float64(p) + (float64(q)-float64(p))*f + 0.5)
+	}
+	return color.NRGBA{R: mix(x.R, y.R), G: mix(x.G, y.G), B: mix(x.B, y.B), A: mix(x.A, y.A)}
+}
+
+// Raises the HSL lightness of c by amount (0 - 1), alpha is kept
+func Lighten(c color.Color, amount float64) color.NRGBA {
+	h, s, l := toHSL(c)
+	return fromHSL(h, s, min(l+amount, 1), toNRGBA(c).A)
+}
+
+// Lowers the HSL lightness of c by amount (0 - 1), alpha is kept
+func Darken(c color.Color, amount float64) color.NRGBA {
+	h, s, l := toHSL(c)
+	return fromHSL(h, s, max(l-amount, 0), toNRGBA(c).A)
+}
+
+// Relative luminance as defined by WCAG, 0 is black and 1 is white
+func Luminance(c color.Color) float64 {
+	n := toNRGBA(c)
+	lin := func(v uint8) float64 {
+		s := float64(v) / 255
+		if s <= 0.04045 {
+			return s / 12.92
+		}
+		return math.Pow((s+0.055)/1.055, 2.4)
+	}
+	return 0.2126*lin(n.R) + 0.7152*lin(n.G) + 0.0722*lin(n.B)
+}
+
+// Contrast ratio of two colors as defined by WCAG, 1 - 21
+// WCAG AA requires 4.5 for normal text
+func ContrastRatio(a, b color.Color) float64 {
+	la, lb := Luminance(a), Luminance(b)
+	if la < lb {
+		la, lb = lb, la
+	}
+	return (la + 0.05) / (lb + 0.05)
+}
+
+// Returns black or white, whichever has the higher contrast on bg
+func Contrast(bg color.Color) color.NRGBA {
+	// contrast against black and white is equal at luminance 0.179
+	if Luminance(bg) > 0.179 {
+		return color.NRGBA{A: 0xff}
+	}
+	return color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}
+}
+
+func toNRGBA(c color.Color) color.NRGBA {
+	if c == nil {
+		return color.NRGBA{}
+	}
+	return color.NRGBAModel.Convert(c).(color.NRGBA)
+}
+
+// Hue in degrees, saturation and lightness 0 - 1
+func toHSL(c color.Color) (h, s, l float64) {
+	n := toNRGBA(c)
+	r, g, b := float64(n.R)/255, float64(n.G)/255, float64(n.B)/255
+	hi := max(r, g, b)
+	lo := min(r, g, b)
+	l = (hi + lo) / 2
+	d := hi - lo
+	if d == 0 {
+		return 0, 0, l
+	}
+	if l > 0.5 {
+		s = d / (2 - hi - lo)
+	} else {
+		s = d / (hi + lo)
+	}
+	switch hi {
+	case r:
+		h = math.Mod((g-b)/d+6, 6)
+	case g:
+		h = (b-r)/d + 2
+	default:
+		h = (r-g)/d + 4
+	}
+	return h * 60, s, l
+}
+
+func fromHSL(h, s, l float64, a uint8) color.NRGBA {
+	c := (1 - math.Abs(2*l-1)) * s
+	x := c * (1 - math.Abs(math.Mod(h/60, 2)-1))
+	m := l - c/2
+	var r, g, b float64
+	switch {
+	case h < 60:
+		r, g, b = c, x, 0
+	case h < 120:
+		r, g, b = x, c, 0
+	case h < 180:
+		r, g, b = 0, c, x
+	case h < 240:
+		r, g, b = 0, x, c
+	case h < 300:
+		r, g, b = x, 0, c
+	default:
+		r, g, b = c, 0, x
+	}
+	conv := func(v float64) uint8 {
+		return uint8(math.Round((v + m) * 255))
+	}
+	return color.NRGBA{R: conv(r), G: conv(g), B: conv(b), A: a}
+}
@@ -0,0 +1,192 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// CountdownLabel counts down to a deadline, changes its colors as the
+// deadline approaches and reports when it expired.
+
+package colorlabel
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+)
+
+// Label counting down to a deadline
+// The colors are selected by SetValueRules with the remaining seconds,
+// by default the text turns red under one minute.
+// The timer only runs while the label is shown.
+type CountdownLabel struct {
+	ColorLabel
+
+	until      time.Time
+	left       time.Duration
+	running    bool
+	expired    bool
+	timer      *time.Timer
+	generation int
+	rendered   bool
+
+	// Formats the remaining time, nil uses FormatCountdown
+	Format func(remaining time.Duration) string
+	// Called once when the deadline is reached
+	OnExpired func()
+}
+
+// Creates a new stopped CountdownLabel
+func NewCountdownLabel() *CountdownLabel {
+	l := &CountdownLabel{}
+	l.initEmbedded()
+	l.value.rules = []Threshold{
+		{Below: 60, TextColor: theme.ColorNameError},
+		{Below: math.Inf(1), TextColor: theme.ColorNameForeground},
+	}
+	l.ExtendBaseWidget(l)
+	l.setRemaining(0)
+	return l
+}
+
+// Widget interface
+func (l *CountdownLabel) CreateRenderer() fyne.WidgetRenderer {
+	l.rendered = true
+	// no refresh while the renderer is created
+	l.setRemaining(l.Remaining())
+	if l.running {
+		l.schedule(l.Remaining())
+	}
+	return &lifecycleRenderer{WidgetRenderer: l.ColorLabel.CreateRenderer(), destroy: func() {
+		l.rendered = false
+		l.stopTimer()
+	}}
+}
+
+// Starts counting down to until
+func (l *CountdownLabel) Start(until time.Time) {
+	l.until = until
+	l.running = true
+	l.expired = false
+	l.update()
+}
+
+// Stops the countdown, the remaining time stays visible
+func (l *CountdownLabel) Stop() {
+	l.left = l.Remaining()
+	l.running = false
+	l.stopTimer()
+}
+
+// Get the remaining time, 0 if expired
+func (l *CountdownLabel) Remaining() time.Duration {
+	if !l.running {
+		return l.left
+	}
+	return max(time.Until(l.until), 0)
+}
+
+// Reports if the deadline is reached
+func (l *CountdownLabel) IsExpired() bool {
+	return l.expired
+}
+
+// Hides the label and stops the timer
+func (l *CountdownLabel) Hide() {
+	l.stopTimer()
+	l.ColorLabel.Hide()
+}
+
+// Shows the label and restarts the timer
+func (l *CountdownLabel) Show() {
+	l.ColorLabel.Show()
+	l.update()
+}
+
+// Formats a duration as "m:ss" or "h:mm:ss", started seconds are rounded up
+func FormatCountdown(d time.Duration) string {
+	s := int((d + time.Second - 1) / time.Second)
+	if s >= 3600 {
+		return fmt.Sprintf("%d:%02d:%02d", s/3600, s/60%60, s%60)
+	}
+	return fmt.Sprintf("%d:%02d", s/60, s%60)
+}
+
+func (l *CountdownLabel) update() {
+	remaining := l.Remaining()
+	l.setRemaining(remaining)
+	l.Refresh()
+	if !l.running {
+		return
+	}
+	if remaining == 0 {
+		l.left = 0
+		l.running = false
+		l.stopTimer()
+		if !l.expired {
+			l.expired = true
+			if l.OnExpired != nil {
+				l.OnExpired()
+			}
+		}
+		return
+	}
+	l.schedule(remaining)
+}
+
+// Sets text and colors for the remaining time
+func (l *CountdownLabel) setRemaining(remaining time.Duration) {
+	l.applyValueColors(math.Ceil(remaining.Seconds()))
+	if l.Format != nil {
+		l.fullText = l.Format(remaining)
+	} else {
+		l.fullText = FormatCountdown(remaining)
+	}
+}
+
+func (l *CountdownLabel) schedule(remaining time.Duration) {
+	l.stopTimer()
+	if !l.rendered || !l.Visible() {
+		return
+	}
+	// next full second of the remaining time
+	d := remaining % time.Second
+	if d == 0 {
+		d = time.Second
+	}
+	gen := l.generation
+	l.timer = time.AfterFunc(d, func() {
+		fyne.Do(func() {
+			if l.generation == gen {
+				l.update()
+			}
+		})
+	})
+}
+
+func (l *CountdownLabel) stopTimer() {
+	l.generation++
+	if l.timer != nil {
+		l.timer.Stop()
+		l.timer = nil
+	}
+}
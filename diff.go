@@ -0,0 +1,160 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Colored display of unified diffs, single lines as DiffLabel and whole
+// diffs in the virtualized DiffView.
+
+package colorlabel
+
+import (
+	"image/color"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+type DiffLineType int
+
+const (
+	DiffContext DiffLineType = iota
+	DiffAdded
+	DiffRemoved
+	DiffHunk
+	DiffHeader
+)
+
+var _ fyne.Widget = (*DiffView)(nil)
+
+// Returns the type of a unified diff line
+func ParseDiffLine(line string) DiffLineType {
+	switch {
+	case strings.HasPrefix(line, "+++"), strings.HasPrefix(line, "---"),
+		strings.HasPrefix(line, "diff "), strings.HasPrefix(line, "index "):
+		return DiffHeader
+	case strings.HasPrefix(line, "@@"):
+		return DiffHunk
+	case strings.HasPrefix(line, "+"):
+		return DiffAdded
+	case strings.HasPrefix(line, "-"):
+		return DiffRemoved
+	}
+	return DiffContext
+}
+
+// Returns text color, background color and text style of a diff line type
+func diffStyle(t DiffLineType) Style {
+	// the tints of both variants, resolved when the label is rendered
+	tint := func(name fyne.ThemeColorName) AdaptiveColor {
+		th := theme.Current()
+		light := color.NRGBAModel.Convert(th.Color(name, theme.VariantLight)).(color.NRGBA)
+		dark := color.NRGBAModel.Convert(th.Color(name, theme.VariantDark)).(color.NRGBA)
+		light.A, dark.A = 0x40, 0x40
+		return AdaptiveColor{Light: light, Dark: dark}
+	}
+	mono := fyne.TextStyle{Monospace: true}
+	switch t {
+	case DiffAdded:
+		return Style{BackgroundColor: tint(theme.ColorNameSuccess), TextStyle: &mono}
+	case DiffRemoved:
+		return Style{BackgroundColor: tint(theme.ColorNameError), TextStyle: &mono}
+	case DiffHunk:
+		return Style{TextColor: theme.ColorNamePrimary, TextStyle: &mono}
+	case DiffHeader:
+		return Style{TextStyle: &fyne.TextStyle{Monospace: true, Bold: true}}
+	}
+	return Style{TextColor: theme.ColorNameDisabled, TextStyle: &mono}
+}
+
+// Creates a new monospace ColorLabel showing a unified diff line
+func NewDiffLabel(line string) *ColorLabel {
+	l := NewColorLabel("", nil, nil, 1)
+	l.SetDiffLine(line)
+	return l
+}
+
+// Set a unified diff line, the colors follow the +/- prefix
+// Added lines get a green, removed lines a red background and
+// context lines are dimmed. Other properties like padding,
+// border and text scale are kept.
+func (l *ColorLabel) SetDiffLine(line string) {
+	st := diffStyle(ParseDiffLine(line))
+	l.BeginUpdate()
+	defer l.EndUpdate()
+	l.SetColors(st.TextColor, st.BackgroundColor)
+	l.SetTextStyle(st.TextStyle)
+	l.SetText(line)
+}
+
+// Scrollable view of a unified diff
+// Only the visible lines are rendered.
+// Implements
+//   - fyne.Widget
+type DiffView struct {
+	widget.BaseWidget
+
+	lines []string
+	list  *widget.List
+}
+
+// Creates a new DiffView
+func NewDiffView(diff string) *DiffView {
+	v := &DiffView{}
+	v.list = widget.NewList(
+		func() int {
+			return len(v.lines)
+		},
+		func() fyne.CanvasObject {
+			l := NewColorLabel("", nil, nil, 1)
+			l.SetTruncateMode(End)
+			return l
+		},
+		func(id widget.ListItemID, o fyne.CanvasObject) {
+			o.(*ColorLabel).SetDiffLine(v.lines[id])
+		},
+	)
+	v.ExtendBaseWidget(v)
+	v.SetDiff(diff)
+	return v
+}
+
+// Widget interface
+func (v *DiffView) CreateRenderer() fyne.WidgetRenderer {
+	return widget.NewSimpleRenderer(v.list)
+}
+
+// Set a new unified diff
+func (v *DiffView) SetDiff(diff string) {
+	diff = strings.TrimSuffix(diff, "\n")
+	v.lines = nil
+	if diff != "" {
+		v.lines = strings.Split(diff, "\n")
+	}
+	v.list.Refresh()
+	v.list.ScrollToTop()
+}
+
+// Get the number of lines
+func (v *DiffView) LineCount() int {
+	return len(v.lines)
+}
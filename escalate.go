@@ -0,0 +1,89 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Severity escalation: a label upgrades its style if it is not
+// acknowledged within a given time.
+
+package colorlabel
+
+import (
+	"time"
+
+	"fyne.io/fyne/v2"
+)
+
+type escalationState struct {
+	timer      *time.Timer
+	generation int
+	escalated  bool
+	saved      Style
+}
+
+// Changes the style of the label to style if it is not acknowledged within d
+// A pending escalation is replaced
+func (l *ColorLabel) EscalateAfter(d time.Duration, style Style) error {
+	if err := validateStyle(style); err != nil {
+		return err
+	}
+	l.stopEscalation()
+	gen := l.escalation.generation
+	l.escalation.timer = time.AfterFunc(d, func() {
+		fyne.Do(func() {
+			if l.escalation.generation != gen {
+				return
+			}
+			l.escalation.timer = nil
+			if !l.escalation.escalated {
+				l.escalation.saved = l.GetStyle()
+			}
+			l.escalation.escalated = true
+			l.ApplyStyle(style)
+			if l.OnEscalated != nil {
+				l.OnEscalated()
+			}
+		})
+	})
+	return nil
+}
+
+// Acknowledges the label
+// Cancels a pending escalation and restores the style used before an escalation
+func (l *ColorLabel) Acknowledge() {
+	l.stopEscalation()
+	if l.escalation.escalated {
+		l.escalation.escalated = false
+		l.ApplyStyle(l.escalation.saved)
+	}
+}
+
+// Reports if the label is escalated and not acknowledged
+func (l *ColorLabel) IsEscalated() bool {
+	return l.escalation.escalated
+}
+
+func (l *ColorLabel) stopEscalation() {
+	l.escalation.generation++
+	if l.escalation.timer != nil {
+		l.escalation.timer.Stop()
+		l.escalation.timer = nil
+	}
+}
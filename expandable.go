@@ -0,0 +1,244 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// ExpandableLabel, a wrapped label limited to a few lines with a "more"
+// toggle which expands it animated to the full height and back.
+
+package colorlabel
+
+import (
+	"math"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+const expandDuration = 200 * time.Millisecond
+
+var _ fyne.Widget = (*ExpandableLabel)(nil)
+
+// Wrapped label showing a limited number of lines
+// If the text is truncated a "more" toggle is shown below the text,
+// tapping it expands the label to the full height, "less" collapses it again.
+// Implements
+//   - fyne.Widget
+type ExpandableLabel struct {
+	widget.BaseWidget
+
+	label    *ColorLabel
+	toggle   *ColorLabel
+	lines    int
+	expanded bool
+	more     string
+	less     string
+	anim     *fyne.Animation
+
+	// Called after the label was expanded or collapsed by the toggle
+	OnExpanded func(expanded bool)
+}
+
+// Creates a new ExpandableLabel showing at most lines lines while collapsed
+func NewExpandableLabel(text string, lines int) *ExpandableLabel {
+	e := &ExpandableLabel{
+		label:  NewColorLabel(text, nil, nil, 1),
+		toggle: NewColorLabel("", theme.ColorNamePrimary, nil, 1),
+		lines:  max(lines, 1),
+		more:   "more",
+		less:   "less",
+	}
+	e.label.SetMaxLines(e.lines)
+	e.toggle.SetAlinment(fyne.TextAlignTrailing)
+	e.toggle.SetText(e.more)
+	e.toggle.OnTapped = func() {
+		e.SetExpanded(!e.expanded)
+		if e.OnExpanded != nil {
+			e.OnExpanded(e.expanded)
+		}
+	}
+	e.ExtendBaseWidget(e)
+	return e
+}
+
+// Set new text
+func (e *ExpandableLabel) SetText(s string) {
+	e.label.SetText(s)
+	e.Refresh()
+}
+
+// Get the text
+func (e *ExpandableLabel) GetText() string {
+	return e.label.GetText()
+}
+
+// Get the label showing the text
+func (e *ExpandableLabel) GetLabel() *ColorLabel {
+	return e.label
+}
+
+// Set the number of lines shown while collapsed
+func (e *ExpandableLabel) SetCollapsedLines(lines int) {
+	e.lines = max(lines, 1)
+	if !e.expanded {
+		e.stopAnimation()
+		e.label.SetMaxLines(e.lines)
+	}
+	e.Refresh()
+}
+
+// Get the number of lines shown while collapsed
+func (e *ExpandableLabel) GetCollapsedLines() int {
+	return e.lines
+}
+
+// Set the texts of the toggle, defaults are "more" and "less"
+func (e *ExpandableLabel) SetToggleTexts(more, less string) {
+	e.more = more
+	e.less = less
+	e.updateToggle()
+	e.Refresh()
+}
+
+// Get the texts of the toggle
+func (e *ExpandableLabel) GetToggleTexts() (string, string) {
+	return e.more, e.less
+}
+
+// Expands or collapses the label animated
+func (e *ExpandableLabel) SetExpanded(expanded bool) {
+	if e.expanded == expanded {
+		return
+	}
+	e.expanded = expanded
+	e.updateToggle()
+	e.animate()
+}
+
+// Reports if the label is expanded
+func (e *ExpandableLabel) IsExpanded() bool {
+	return e.expanded
+}
+
+func (e *ExpandableLabel) updateToggle() {
+	if e.expanded {
+		e.toggle.SetText(e.less)
+	} else {
+		e.toggle.SetText(e.more)
+	}
+}
+
+// Steps the visible lines between the collapsed and the full number of lines,
+// the parent layout follows the growing or shrinking label
+func (e *ExpandableLabel) animate() {
+	e.stopAnimation()
+	needed := max(e.label.GetLinesNeeded(), e.lines)
+	from := e.label.GetMaxLines()
+	if from <= 0 {
+		from = needed
+	}
+	to := e.lines
+	if e.expanded {
+		to = needed
+	}
+	e.anim = fyne.NewAnimation(expandDuration, func(f float32) {
+		n := from + int(math.Round(float64(f)*float64(to-from)))
+		if f >= 1 {
+			e.anim = nil
+			if e.expanded {
+				n = -1
+			}
+		}
+		e.label.SetMaxLines(n)
+		e.Refresh()
+	})
+	e.anim.Curve = fyne.AnimationEaseInOut
+	e.anim.Start()
+}
+
+func (e *ExpandableLabel) stopAnimation() {
+	if e.anim != nil {
+		e.anim.Stop()
+		e.anim = nil
+	}
+}
+
+// Reports if the toggle is shown
+func (e *ExpandableLabel) toggleShown() bool {
+	return e.expanded || e.anim != nil || e.label.IsTruncated()
+}
+
+// Widget interface
+func (e *ExpandableLabel) CreateRenderer() fyne.WidgetRenderer {
+	return &expandableLabelRenderer{e: e}
+}
+
+type expandableLabelRenderer struct {
+	e     *ExpandableLabel
+	shown bool
+}
+
+// WidgetRenderer interface
+func (r *expandableLabelRenderer) Layout(size fyne.Size) {
+	e := r.e
+	ts := e.toggle.MinSize()
+	ls := e.label.MinSize()
+	e.label.Move(fyne.NewPos(0, 0))
+	e.label.Resize(fyne.NewSize(size.Width, ls.Height))
+	// the label knows if it is truncated after it was laid out with the width
+	shown := e.toggleShown()
+	e.toggle.Hidden = !shown
+	e.toggle.Move(fyne.NewPos(0, e.label.MinSize().Height))
+	e.toggle.Resize(fyne.NewSize(size.Width, ts.Height))
+	if shown != r.shown {
+		r.shown = shown
+		canvas.Refresh(e)
+	}
+}
+
+// WidgetRenderer interface
+func (r *expandableLabelRenderer) MinSize() fyne.Size {
+	ls := r.e.label.MinSize()
+	if !r.e.toggleShown() {
+		return ls
+	}
+	ts := r.e.toggle.MinSize()
+	return fyne.NewSize(max(ls.Width, ts.Width), ls.Height+ts.Height)
+}
+
+// WidgetRenderer interface
+func (r *expandableLabelRenderer) Refresh() {
+	r.e.label.Refresh()
+	r.e.toggle.Refresh()
+	r.Layout(r.e.Size())
+}
+
+// WidgetRenderer interface
+func (r *expandableLabelRenderer) Destroy() {
+	r.e.stopAnimation()
+}
+
+// WidgetRenderer interface
+func (r *expandableLabelRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.e.label, r.e.toggle}
+}
@@ -0,0 +1,127 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Offscreen rendering of a label to an image, e.g. for reports,
+// clipboard images or custom canvases.
+
+package colorlabel
+
+import (
+	"errors"
+	"image"
+	"slices"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/driver/software"
+)
+
+// Returned by RenderToImageScaled for a scale <= 0
+var ErrInvalidScale = errors.New("scale must be greater than 0")
+
+// Renders text and background of the label to an image with scale 1
+// The current size of the label is used, before it is laid out the MinSize.
+func (l *ColorLabel) RenderToImage() (image.Image, error) {
+	return l.RenderToImageScaled(1)
+}
+
+// Renders text and background of the label to an image
+// scale is the pixel density, e.g. 2 for an image with twice the size in pixels.
+// A copy of the label is rendered, the label itself is not changed.
+// Animations are rendered in their current state, a loading label without the shimmer.
+func (l *ColorLabel) RenderToImageScaled(scale float32) (image.Image, error) {
+	if scale <= 0 {
+		return nil, ErrInvalidScale
+	}
+	c := NewColorLabel("", nil, nil, 1)
+	l.copyAppearance(c)
+	size := l.Size()
+	if size.Width <= 0 || size.Height <= 0 {
+		size = c.MinSize()
+	}
+	if size.Width <= 0 || size.Height <= 0 {
+		return image.NewNRGBA(image.Rect(0, 0, 0, 0)), nil
+	}
+	return renderOffscreen(c, size, scale), nil
+}
+
+// Renders o with the given size on a transparent offscreen canvas
+func renderOffscreen(o fyne.CanvasObject, size fyne.Size, scale float32) image.Image {
+	c := software.NewTransparentCanvas()
+	c.SetPadded(false)
+	c.SetScale(scale)
+	c.SetContent(o)
+	c.Resize(size)
+	return c.Capture()
+}
+
+// Copies everything which changes how the label looks to c
+// Callbacks, bindings, timers and animations are not copied,
+// the caret of c does not blink and the loading bar of c does not shimmer.
+func (l *ColorLabel) copyAppearance(c *ColorLabel) {
+	c.offscreen = true
+	c.fullText = l.fullText
+	c.segments = l.segments
+	c.segmentsGen++
+	c.rules = ruleState{rules: slices.Clone(l.rules.rules)}
+	c.fgColor = l.fgColor
+	c.bgColor = l.bgColor
+	c.textScale = l.textScale
+	style := *l.textStyle
+	c.textStyle = &style
+	c.truncate = l.truncate
+	c.alignment = l.alignment
+	c.importance = l.importance
+	c.ellipsis = l.ellipsis
+	c.sizeToContent = l.sizeToContent
+	c.transform = l.transform
+	c.locale = l.locale
+	c.widthLimit = l.widthLimit
+	c.padding = l.padding
+	c.margins = l.margins
+	c.disabled = l.disabled
+	c.selected = l.selected
+	c.pressed = l.pressed
+	c.hover.hovered = l.hover.hovered
+	c.stateStyles = l.stateStyles
+	c.borderColor = l.borderColor
+	c.borderWidth = l.borderWidth
+	c.caret = l.caret
+	c.caret.blink = false
+	c.flash.color, c.flash.alpha = l.flash.color, l.flash.alpha
+	c.fade.active, c.fade.alpha = l.fade.active, l.fade.alpha
+	c.pulse.color, c.pulse.amount = l.pulse.color, l.pulse.amount
+	c.loading = l.loading
+	c.progress = l.progress
+	c.accent = l.accent
+	c.pattern = l.pattern
+	c.bgImage = l.bgImage
+	c.outline = l.outline
+	c.placeholder = l.placeholder
+	c.mask = l.mask
+	c.affix.prefix, c.affix.suffix = l.affix.prefix, l.affix.suffix
+	c.affix.gen++
+	c.wrap.maxLines = l.wrap.maxLines
+	c.orientation = l.orientation
+	c.tabWidth = l.tabWidth
+	c.lineSpacing = l.lineSpacing
+	c.letterSpacing = l.letterSpacing
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Fade in and fade out animations, text and background alpha are
+// scaled while the animation runs.
+
+package colorlabel
+
+import (
+	"image/color"
+	"time"
+
+	"fyne.io/fyne/v2"
+)
+
+type fadeState struct {
+	active bool
+	alpha  float32
+	anim   *fyne.Animation
+}
+
+// Shows the label and fades text and background in within d
+func (l *ColorLabel) ShowAnimated(d time.Duration) {
+	l.stopFade()
+	l.fade.active = true
+	l.fade.alpha = 0
+	l.Show()
+	l.startFade(d, func(f float32) {
+		l.fade.alpha = f
+	}, nil)
+}
+
+// Fades text and background out within d and hides the label
+func (l *ColorLabel) HideAnimated(d time.Duration) {
+	l.stopFade()
+	if !l.Visible() {
+		return
+	}
+	l.fade.active = true
+	l.fade.alpha = 1
+	l.startFade(d, func(f float32) {
+		l.fade.alpha = 1 - f
+	}, l.Hide)
+}
+
+func (l *ColorLabel) startFade(d time.Duration, tick func(float32), done func()) {
+	l.fade.anim = fyne.NewAnimation(d, func(f float32) {
+		tick(f)
+		if f >= 1 {
+			l.fade.active = false
+			l.fade.anim = nil
+			if done != nil {
+				done()
+			}
+		}
+		l.Refresh()
+	})
+	l.fade.anim.Curve = fyne.AnimationEaseInOut
+	l.fade.anim.Start()
+}
+
+func (l *ColorLabel) stopFade() {
+	if l.fade.anim != nil {
+		l.fade.anim.Stop()
+		l.fade.anim = nil
+	}
+	if l.fade.active {
+		l.fade.active = false
+		l.Refresh()
+	}
+}
+
+// Returns c with the alpha of a running fade animation
+func (l *ColorLabel) fadeColor(c color.Color) color.Color {
+	if !l.fade.active || c == nil {
+		return c
+	}
+	n := color.NRGBAModel.Convert(c).(color.NRGBA)
+	n.A = uint8(float32(n.A) * l.fade.alpha)
+	return n
+}
+
+// Resolves a color spec for rendering, including a running fade
+func (l *ColorLabel) renderColor(c any) color.Color {
+	return l.fadeColor(getColor(c))
+}
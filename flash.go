@@ -0,0 +1,118 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Flashing the background, e.g. the stock ticker pattern where a
+// changed value briefly lights up green or red and fades back.
+
+package colorlabel
+
+import (
+	"fmt"
+	"image/color"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+
+	"github.com/bytemystery-com/colorlabel/colorutil"
+)
+
+const defaultFlashDuration = 800 * time.Millisecond
+
+type flashState struct {
+	color any
+	alpha float32
+	anim  *fyne.Animation
+}
+
+type valueState struct {
+	last   float64
+	valid  bool
+	format string
+	rules  []Threshold
+}
+
+// Flashes the background with color c, which fades back within d
+// c is NRGBA or fyne.ThemeColorName
+func (l *ColorLabel) Flash(c any, d time.Duration) error {
+	if err := ValidateColor(c); err != nil {
+		return err
+	}
+	l.stopFlash()
+	l.flash.color = c
+	l.flash.alpha = 1
+	l.flash.anim = fyne.NewAnimation(d, func(f float32) {
+		l.flash.alpha = 1 - f
+		l.Refresh()
+	})
+	l.flash.anim.Curve = fyne.AnimationEaseOut
+	l.flash.anim.Start()
+	return nil
+}
+
+// Shows the value and flashes the background green if it is higher or
+// red if it is lower than the previous value
+// The colors are selected by ConditionalColor or SetValueRules.
+func (l *ColorLabel) UpdateValue(v float64) {
+	format := l.value.format
+	if format == "" {
+		format = "%g"
+	}
+	if l.value.valid && v != l.value.last {
+		c := theme.ColorNameSuccess
+		if v < l.value.last {
+			c = theme.ColorNameError
+		}
+		l.Flash(c, defaultFlashDuration)
+	}
+	l.BeginUpdate()
+	defer l.EndUpdate()
+	l.value.last = v
+	l.value.valid = true
+	l.applyValueColors(v)
+	l.SetText(fmt.Sprintf(format, v))
+	// the colors may change even if the text does not
+	l.Refresh()
+}
+
+// Set the fmt format used by UpdateValue, default is "%g"
+func (l *ColorLabel) SetValueFormat(format string) {
+	l.value.format = format
+}
+
+func (l *ColorLabel) stopFlash() {
+	if l.flash.anim != nil {
+		l.flash.anim.Stop()
+		l.flash.anim = nil
+	}
+	l.flash.alpha = 0
+}
+
+// Returns bg with the fading flash color on top
+func (l *ColorLabel) flashOverlay(bg color.Color) color.Color {
+	if l.flash.alpha <= 0 {
+		return bg
+	}
+	c := color.NRGBAModel.Convert(getColor(l.flash.color)).(color.NRGBA)
+	c.A = uint8(float32(c.A) * l.flash.alpha)
+	return colorutil.Over(bg, c)
+}
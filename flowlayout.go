@@ -0,0 +1,89 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Internal layout placing objects in rows and wrapping them at the
+// available width.
+
+package colorlabel
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+)
+
+var _ fyne.Layout = (*flowLayout)(nil)
+
+// Flow layout, objects keep their MinSize and wrap into new rows
+// The width of the last layout is remembered so that MinSize can report
+// the height needed for the wrapped rows.
+type flowLayout struct {
+	width float32
+}
+
+func newFlowLayout() *flowLayout {
+	return &flowLayout{}
+}
+
+// Layout interface
+func (f *flowLayout) Layout(objects []fyne.CanvasObject, size fyne.Size) {
+	f.width = size.Width
+	f.place(objects, size.Width, true)
+}
+
+// Layout interface
+func (f *flowLayout) MinSize(objects []fyne.CanvasObject) fyne.Size {
+	var w float32
+	for _, o := range objects {
+		if o.Visible() {
+			w = max(w, o.MinSize().Width)
+		}
+	}
+	width := f.width
+	if width <= 0 {
+		width = w
+	}
+	return fyne.NewSize(w, f.place(objects, max(width, w), false))
+}
+
+// Places the objects in rows no wider than width and returns the total height
+func (f *flowLayout) place(objects []fyne.CanvasObject, width float32, move bool) float32 {
+	pad := theme.Padding()
+	var x, y, rowHeight float32
+	for _, o := range objects {
+		if !o.Visible() {
+			continue
+		}
+		s := o.MinSize()
+		if x > 0 && x+s.Width > width {
+			x = 0
+			y += rowHeight + pad
+			rowHeight = 0
+		}
+		if move {
+			o.Resize(s)
+			o.Move(fyne.NewPos(x, y))
+		}
+		x += s.Width + pad
+		rowHeight = max(rowHeight, s.Height)
+	}
+	return y + rowHeight
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Read only form values, a ColorLabel padded like widget.Label and
+// widget.Entry so the text lines up in widget.Form rows.
+
+package colorlabel
+
+import (
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// Creates a ColorLabel for a read only form value
+// The label uses the inner padding of widget.Entry, so its height and the
+// text baseline match the other rows. A padding in the style is kept.
+// Returns nil if a color of the style is not supported
+func NewFormValue(s string, style Style) *ColorLabel {
+	if style.Padding == nil {
+		p := theme.InnerPadding()
+		style.Padding = &Padding{Top: p, Right: p, Bottom: p, Left: p}
+	}
+	return NewColorLabelWithStyle(s, style)
+}
+
+// Creates a form item with a read only styled value
+// The ColorLabel is the Widget of the item.
+// Returns nil if a color of the style is not supported
+func NewFormItem(text, value string, style Style) *widget.FormItem {
+	l := NewFormValue(value, style)
+	if l == nil {
+		return nil
+	}
+	return widget.NewFormItem(text, l)
+}
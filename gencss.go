@@ -0,0 +1,96 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Generates csscolors_table.go with the CSS level 4 named colors.
+// The SVG 1.1 colors of golang.org/x/image/colornames are the CSS colors
+// except rebeccapurple and transparent, which CSS level 4 adds.
+
+//go:build ignore
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/format"
+	"image/color"
+	"log"
+	"os"
+	"sort"
+	"strings"
+
+	"golang.org/x/image/colornames"
+)
+
+func main() {
+	colors := make(map[string]color.RGBA, len(colornames.Map)+2)
+	for name, c := range colornames.Map {
+		colors[name] = c
+	}
+	colors["rebeccapurple"] = color.RGBA{R: 0x66, G: 0x33, B: 0x99, A: 0xff}
+	colors["transparent"] = color.RGBA{}
+	names := make([]string, 0, len(colors))
+	for name := range colors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var b bytes.Buffer
+	b.WriteString(license())
+	b.WriteString("//\n// Code generated by gencss.go; DO NOT EDIT.\n\npackage colorlabel\n\n")
+	b.WriteString("import \"image/color\"\n\n// CSS level 4 named colors\nvar cssColors = map[string]color.NRGBA{\n")
+	for _, name := range names {
+		c := colors[name]
+		if c.A == 0 {
+			fmt.Fprintf(&b, "\t%q: {},\n", name)
+			continue
+		}
+		fmt.Fprintf(&b, "\t%q: {R: 0x%02x, G: 0x%02x, B: 0x%02x, A: 0x%02x},\n", name, c.R, c.G, c.B, c.A)
+	}
+	b.WriteString("}\n")
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("csscolors_table.go", src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// Returns the license header of csscolors.go
+func license() string {
+	f, err := os.Open("csscolors.go")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+	var sb strings.Builder
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		sb.WriteString(s.Text() + "\n")
+		if strings.HasPrefix(s.Text(), "// SPDX-License-Identifier") {
+			break
+		}
+	}
+	return sb.String()
+}
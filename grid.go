@@ -0,0 +1,247 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// ColorLabelGrid is a scrollable grid of small ColorLabels, e.g. a
+// calendar heatmap or a memory map. Only the visible cells are realized,
+// the labels are recycled while scrolling.
+
+package colorlabel
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/widget"
+)
+
+// space between the cells
+const gridGap = 2
+
+var _ fyne.Widget = (*ColorLabelGrid)(nil)
+
+// Virtualized grid of ColorLabels
+// Implements
+//   - fyne.Widget
+type ColorLabelGrid struct {
+	widget.BaseWidget
+
+	rows     int
+	cols     int
+	cellSize fyne.Size
+	content  *gridContent
+	scroll   *container.Scroll
+
+	// Updates the label of a cell whenever it is shown
+	UpdateCell func(row, col int, l *ColorLabel)
+	// Called when a cell is tapped
+	OnCellTapped func(row, col int)
+}
+
+// Creates a new ColorLabelGrid with rows x cols cells of the given size
+// update is called to fill the label of a visible cell
+func NewColorLabelGrid(rows, cols int, cellSize fyne.Size, update func(row, col int, l *ColorLabel)) *ColorLabelGrid {
+	g := &ColorLabelGrid{
+		rows:       max(rows, 0),
+		cols:       max(cols, 0),
+		cellSize:   cellSize,
+		UpdateCell: update,
+	}
+	g.content = &gridContent{g: g}
+	g.content.ExtendBaseWidget(g.content)
+	g.scroll = container.NewScroll(g.content)
+	g.scroll.OnScrolled = func(fyne.Position) {
+		g.content.Refresh()
+	}
+	g.ExtendBaseWidget(g)
+	return g
+}
+
+// Widget interface
+func (g *ColorLabelGrid) CreateRenderer() fyne.WidgetRenderer {
+	return widget.NewSimpleRenderer(g.scroll)
+}
+
+// Set the number of rows and columns
+func (g *ColorLabelGrid) SetGridSize(rows, cols int) {
+	g.rows = max(rows, 0)
+	g.cols = max(cols, 0)
+	g.Refresh()
+}
+
+// Get the number of rows and columns
+func (g *ColorLabelGrid) GetGridSize() (int, int) {
+	return g.rows, g.cols
+}
+
+// Set the size of a cell
+func (g *ColorLabelGrid) SetCellSize(size fyne.Size) {
+	g.cellSize = size
+	g.Refresh()
+}
+
+// Get the size of a cell
+func (g *ColorLabelGrid) GetCellSize() fyne.Size {
+	return g.cellSize
+}
+
+// Updates all visible cells
+func (g *ColorLabelGrid) Refresh() {
+	g.content.invalidate()
+	g.scroll.Refresh()
+	g.BaseWidget.Refresh()
+}
+
+// Scrolls so the cell is visible
+func (g *ColorLabelGrid) ScrollToCell(row, col int) {
+	pos := g.cellPosition(row, col)
+	off := g.scroll.Offset
+	size := g.scroll.Size()
+	if pos.X < off.X {
+		off.X = pos.X
+	} else if pos.X+g.cellSize.Width > off.X+size.Width {
+		off.X = pos.X + g.cellSize.Width - size.Width
+	}
+	if pos.Y < off.Y {
+		off.Y = pos.Y
+	} else if pos.Y+g.cellSize.Height > off.Y+size.Height {
+		off.Y = pos.Y + g.cellSize.Height - size.Height
+	}
+	g.scroll.ScrollToOffset(off)
+	g.content.Refresh()
+}
+
+func (g *ColorLabelGrid) cellPosition(row, col int) fyne.Position {
+	return fyne.NewPos(float32(col)*(g.cellSize.Width+gridGap), float32(row)*(g.cellSize.Height+gridGap))
+}
+
+// Scrolled content with the size of the whole grid
+type gridContent struct {
+	widget.BaseWidget
+
+	g     *ColorLabelGrid
+	valid bool
+}
+
+func (c *gridContent) invalidate() {
+	c.valid = false
+}
+
+// Widget interface
+func (c *gridContent) CreateRenderer() fyne.WidgetRenderer {
+	return &gridContentRenderer{c: c}
+}
+
+type gridCell struct {
+	row int
+	col int
+}
+
+type gridContentRenderer struct {
+	c      *gridContent
+	labels []*ColorLabel
+	cells  []gridCell
+	objs   []fyne.CanvasObject
+}
+
+// WidgetRenderer interface
+func (r *gridContentRenderer) Layout(_ fyne.Size) {
+	r.layoutVisible()
+}
+
+// WidgetRenderer interface
+func (r *gridContentRenderer) MinSize() fyne.Size {
+	g := r.c.g
+	if g.rows == 0 || g.cols == 0 {
+		return fyne.NewSize(0, 0)
+	}
+	return fyne.NewSize(float32(g.cols)*(g.cellSize.Width+gridGap)-gridGap,
+		float32(g.rows)*(g.cellSize.Height+gridGap)-gridGap)
+}
+
+// WidgetRenderer interface
+func (r *gridContentRenderer) Refresh() {
+	r.layoutVisible()
+}
+
+// WidgetRenderer interface
+func (r *gridContentRenderer) Destroy() {
+}
+
+// WidgetRenderer interface
+func (r *gridContentRenderer) Objects() []fyne.CanvasObject {
+	return r.objs
+}
+
+// Shows the labels of the visible cells, labels still showing the same
+// cell are not updated again
+func (r *gridContentRenderer) layoutVisible() {
+	g := r.c.g
+	stepX := g.cellSize.Width + gridGap
+	stepY := g.cellSize.Height + gridGap
+	if stepX <= gridGap || stepY <= gridGap {
+		return
+	}
+	off := g.scroll.Offset
+	view := g.scroll.Size()
+	col0 := max(int(off.X/stepX), 0)
+	row0 := max(int(off.Y/stepY), 0)
+	col1 := min(int((off.X+view.Width)/stepX)+1, g.cols)
+	row1 := min(int((off.Y+view.Height)/stepY)+1, g.rows)
+
+	n := 0
+	for row := row0; row < row1; row++ {
+		for col := col0; col < col1; col++ {
+			if n == len(r.labels) {
+				r.addLabel()
+			}
+			l := r.labels[n]
+			cell := gridCell{row: row, col: col}
+			if !r.c.valid || r.cells[n] != cell || l.Hidden {
+				r.cells[n] = cell
+				if g.UpdateCell != nil {
+					g.UpdateCell(row, col, l)
+				}
+			}
+			l.Move(g.cellPosition(row, col))
+			l.Resize(g.cellSize)
+			l.Show()
+			n++
+		}
+	}
+	for _, l := range r.labels[n:] {
+		l.Hide()
+	}
+	r.c.valid = true
+}
+
+func (r *gridContentRenderer) addLabel() {
+	i := len(r.labels)
+	l := NewColorLabel("", nil, nil, 1)
+	l.SetTruncateMode(End)
+	l.OnTapped = func() {
+		if f := r.c.g.OnCellTapped; f != nil {
+			f(r.cells[i].row, r.cells[i].col)
+		}
+	}
+	r.labels = append(r.labels, l)
+	r.cells = append(r.cells, gridCell{row: -1, col: -1})
+	r.objs = append(r.objs, l)
+}
@@ -0,0 +1,147 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Radio style group of labels where at most one label is selected,
+// e.g. for segmented controls or tabs.
+
+package colorlabel
+
+// Group of labels with at most one selected label
+type ColorLabelGroup struct {
+	labels   []*ColorLabel
+	selected int
+
+	// If true tapping the selected label deselects it
+	AllowDeselect bool
+	// Called with the index of the new selected label, -1 if none is selected
+	OnChanged func(index int)
+}
+
+// Creates a new group without selection
+func NewColorLabelGroup(labels ...*ColorLabel) *ColorLabelGroup {
+	g := &ColorLabelGroup{selected: -1}
+	for _, l := range labels {
+		g.Add(l)
+	}
+	return g
+}
+
+// Adds a label to the group
+// A label can be in one group only
+func (g *ColorLabelGroup) Add(l *ColorLabel) {
+	if l.group != nil {
+		l.group.Remove(l)
+	}
+	l.group = g
+	l.setSelected(false)
+	g.labels = append(g.labels, l)
+}
+
+// Removes a label from the group
+func (g *ColorLabelGroup) Remove(l *ColorLabel) {
+	for i, gl := range g.labels {
+		if gl != l {
+			continue
+		}
+		g.labels = append(g.labels[:i], g.labels[i+1:]...)
+		l.group = nil
+		switch {
+		case g.selected == i:
+			g.selected = -1
+			l.setSelected(false)
+			g.changed()
+		case g.selected > i:
+			g.selected--
+		}
+		return
+	}
+}
+
+// Get the labels of the group
+func (g *ColorLabelGroup) Labels() []*ColorLabel {
+	return g.labels
+}
+
+// Get the index of the selected label, -1 if none is selected
+func (g *ColorLabelGroup) Selected() int {
+	return g.selected
+}
+
+// Get the selected label, nil if none is selected
+func (g *ColorLabelGroup) SelectedLabel() *ColorLabel {
+	if g.selected < 0 {
+		return nil
+	}
+	return g.labels[g.selected]
+}
+
+// Selects the label with the given index, -1 clears the selection
+func (g *ColorLabelGroup) SetSelected(index int) {
+	if index < -1 || index >= len(g.labels) || index == g.selected {
+		return
+	}
+	if g.selected >= 0 {
+		g.labels[g.selected].setSelected(false)
+	}
+	g.selected = index
+	if index >= 0 {
+		g.labels[index].setSelected(true)
+	}
+	g.changed()
+}
+
+// Selects or deselects a label of the group, other labels are deselected
+func (g *ColorLabelGroup) setLabelSelected(l *ColorLabel, selected bool) {
+	for i, gl := range g.labels {
+		if gl != l {
+			continue
+		}
+		switch {
+		case selected:
+			g.SetSelected(i)
+		case i == g.selected:
+			g.SetSelected(-1)
+		}
+		return
+	}
+}
+
+func (g *ColorLabelGroup) tapped(l *ColorLabel) {
+	for i, gl := range g.labels {
+		if gl == l {
+			if i == g.selected {
+				if g.AllowDeselect {
+					g.SetSelected(-1)
+				}
+				return
+			}
+			g.SetSelected(i)
+			return
+		}
+	}
+}
+
+func (g *ColorLabelGroup) changed() {
+	if g.OnChanged != nil {
+		g.OnChanged(g.selected)
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// HeatLabel maps a value to a background color along a gradient, with
+// a text color chosen for contrast. Useful for metric table cells.
+
+package colorlabel
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+
+	"fyne.io/fyne/v2"
+
+	"github.com/bytemystery-com/colorlabel/colorutil"
+)
+
+// Colors spread evenly from the minimum to the maximum value
+type Gradient []color.Color
+
+var (
+	// Green over yellow to red, e.g. for load or error rates
+	GradientGreenYellowRed = Gradient{
+		color.NRGBA{0x1a, 0x98, 0x50, 0xff},
+		color.NRGBA{0xfe, 0xe0, 0x8b, 0xff},
+		color.NRGBA{0xd7, 0x30, 0x27, 0xff},
+	}
+	// Dark blue over red to yellow
+	GradientHeat = Gradient{
+		color.NRGBA{0x0d, 0x08, 0x87, 0xff},
+		color.NRGBA{0xcc, 0x47, 0x78, 0xff},
+		color.NRGBA{0xf0, 0xf9, 0x21, 0xff},
+	}
+	// Perceptually uniform viridis palette
+	GradientViridis = Gradient{
+		color.NRGBA{0x44, 0x01, 0x54, 0xff},
+		color.NRGBA{0x3b, 0x52, 0x8b, 0xff},
+		color.NRGBA{0x21, 0x90, 0x8d, 0xff},
+		color.NRGBA{0x5d, 0xc8, 0x63, 0xff},
+		color.NRGBA{0xfd, 0xe7, 0x25, 0xff},
+	}
+	// Light to dark blue
+	GradientBlues = Gradient{
+		color.NRGBA{0xf7, 0xfb, 0xff, 0xff},
+		color.NRGBA{0x6b, 0xae, 0xd6, 0xff},
+		color.NRGBA{0x08, 0x30, 0x6b, 0xff},
+	}
+)
+
+// Returns the color at f in [0, 1]
+func (g Gradient) At(f float64) color.NRGBA {
+	if len(g) == 0 {
+		return color.NRGBA{}
+	}
+	if math.IsNaN(f) {
+		f = 0
+	}
+	f = min(max(f, 0), 1) * float64(len(g)-1)
+	i := int(f)
+	if i >= len(g)-1 {
+		return color.NRGBAModel.Convert(g[len(g)-1]).(color.NRGBA)
+	}
+	return colorutil.Mix(g[i], g[i+1], f-float64(i))
+}
+
+// Label with a background color taken from a gradient by its value
+type HeatLabel struct {
+	ColorLabel
+
+	heat     float64
+	min, max float64
+	gradient Gradient
+}
+
+// Creates a new HeatLabel for a value in [minValue, maxValue]
+func NewHeatLabel(value, minValue, maxValue float64) *HeatLabel {
+	h := &HeatLabel{
+		min:      minValue,
+		max:      maxValue,
+		gradient: GradientGreenYellowRed,
+	}
+	h.initEmbedded()
+	h.alignment = fyne.TextAlignCenter
+	h.ExtendBaseWidget(h)
+	h.SetValue(value)
+	return h
+}
+
+// Set a new value
+func (h *HeatLabel) SetValue(v float64) {
+	h.heat = v
+	h.update()
+}
+
+// Get the value
+func (h *HeatLabel) GetValue() float64 {
+	return h.heat
+}
+
+// Set the value range mapped onto the gradient
+func (h *HeatLabel) SetRange(minValue, maxValue float64) {
+	h.min, h.max = minValue, maxValue
+	h.update()
+}
+
+// Set the gradient, nil uses GradientGreenYellowRed
+func (h *HeatLabel) SetGradient(g Gradient) {
+	if len(g) == 0 {
+		g = GradientGreenYellowRed
+	}
+	h.gradient = g
+	h.update()
+}
+
+func (h *HeatLabel) update() {
+	f := 0.0
+	if h.max != h.min {
+		f = (h.heat - h.min) / (h.max - h.min)
+	}
+	bg := h.gradient.At(f)
+	h.bgColor = bg
+	h.fgColor = colorutil.Contrast(bg)
+	format := h.value.format
+	if format == "" {
+		format = "%g"
+	}
+	h.fullText = fmt.Sprintf(format, h.heat)
+	h.Refresh()
+}
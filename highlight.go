@@ -0,0 +1,162 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Highlighting parts of the text, e.g. the matches of a search.
+
+package colorlabel
+
+import (
+	"unicode"
+
+	"fyne.io/fyne/v2/theme"
+)
+
+// Part of the text given in runes, End is exclusive
+type Range struct {
+	Start, End int
+}
+
+// Renders the ranges of the text with the highlight style
+// TextColor, BackgroundColor and TextStyle of style are used, nil values
+// keep the colors of the text. If both colors are nil the theme selection
+// color is used as background.
+// The ranges are applied on top of segments, e.g. from SetMarkup.
+// Empty ranges remove the highlight again.
+func (l *ColorLabel) HighlightRanges(ranges []Range, style Style) error {
+	if err := ValidateColor(style.TextColor); err != nil {
+		return err
+	}
+	if err := ValidateColor(style.BackgroundColor); err != nil {
+		return err
+	}
+	if l.highlightBase == nil {
+		l.highlightBase = l.segments
+		if l.highlightBase == nil {
+			l.highlightBase = []Segment{{Text: l.fullText}}
+		}
+	}
+	base := l.highlightBase
+	if len(ranges) == 0 {
+		l.ClearHighlight()
+		return nil
+	}
+	if style.TextColor == nil && style.BackgroundColor == nil {
+		style.BackgroundColor = theme.ColorNameSelection
+	}
+	var segs []Segment
+	pos := 0
+	for _, s := range base {
+		runes := []rune(s.Text)
+		start := 0
+		for i := range runes {
+			if i > start && highlighted(ranges, pos+i) != highlighted(ranges, pos+i-1) {
+				segs = append(segs, highlightSegment(s, string(runes[start:i]), highlighted(ranges, pos+start), style))
+				start = i
+			}
+		}
+		if start < len(runes) {
+			segs = append(segs, highlightSegment(s, string(runes[start:]), highlighted(ranges, pos+start), style))
+		}
+		pos += len(runes)
+	}
+	l.setSegments(segs)
+	l.Refresh()
+	return nil
+}
+
+// Highlights all case insensitive occurrences of query, see HighlightRanges
+// Returns the number of matches
+func (l *ColorLabel) HighlightSubstring(query string, style Style) (int, error) {
+	text := l.fullText
+	if l.highlightBase != nil {
+		text = ""
+		for _, s := range l.highlightBase {
+			text += s.Text
+		}
+	}
+	ranges := findRanges([]rune(text), []rune(query))
+	return len(ranges), l.HighlightRanges(ranges, style)
+}
+
+// Removes the highlight and restores the text before highlighting
+func (l *ColorLabel) ClearHighlight() {
+	base := l.highlightBase
+	if base == nil {
+		return
+	}
+	l.highlightBase = nil
+	if len(base) == 1 && base[0] == (Segment{Text: base[0].Text}) {
+		l.SetText(base[0].Text)
+		return
+	}
+	l.setSegments(base)
+	l.Refresh()
+}
+
+func highlighted(ranges []Range, i int) bool {
+	for _, r := range ranges {
+		if i >= r.Start && i < r.End {
+			return true
+		}
+	}
+	return false
+}
+
+func highlightSegment(s Segment, text string, on bool, style Style) Segment {
+	s.Text = text
+	if !on {
+		return s
+	}
+	if style.TextColor != nil {
+		s.TextColor = style.TextColor
+	}
+	if style.BackgroundColor != nil {
+		s.BackgroundColor = style.BackgroundColor
+	}
+	if style.TextStyle != nil {
+		ts := *style.TextStyle
+		s.TextStyle = &ts
+	}
+	return s
+}
+
+// Returns the case insensitive, non overlapping occurrences of query in text
+func findRanges(text, query []rune) []Range {
+	if len(query) == 0 {
+		return nil
+	}
+	var ranges []Range
+	for i := 0; i+len(query) <= len(text); i++ {
+		match := true
+		for j, q := range query {
+			if unicode.ToLower(text[i+j]) != unicode.ToLower(q) {
+				match = false
+				break
+			}
+		}
+		if match {
+			ranges = append(ranges, Range{Start: i, End: i + len(query)})
+			i += len(query) - 1
+		}
+	}
+	return ranges
+}
@@ -0,0 +1,216 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Hover handling for ColorLabel. Hover colors and tool tips engage after
+// a delay and disengage after an exit delay, so sweeping the mouse across
+// dense lists does not flicker.
+
+package colorlabel
+
+import (
+	"sync/atomic"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/widget"
+)
+
+var (
+	defaultHoverDelay     atomic.Int64
+	defaultHoverExitDelay atomic.Int64
+)
+
+func init() {
+	defaultHoverDelay.Store(int64(150 * time.Millisecond))
+	defaultHoverExitDelay.Store(int64(100 * time.Millisecond))
+}
+
+// Set the default delays used by all labels without own hover delays
+// enter is the time the mouse must stay over a label before hover colors and tool tip engage
+// exit is the time after leaving a label before they disengage
+func SetDefaultHoverDelay(enter, exit time.Duration) {
+	defaultHoverDelay.Store(int64(max(enter, 0)))
+	defaultHoverExitDelay.Store(int64(max(exit, 0)))
+}
+
+// Get the default hover delays
+func GetDefaultHoverDelay() (time.Duration, time.Duration) {
+	return time.Duration(defaultHoverDelay.Load()), time.Duration(defaultHoverExitDelay.Load())
+}
+
+type hoverState struct {
+	delay      time.Duration
+	exitDelay  time.Duration
+	ownDelay   bool
+	hovered    bool
+	inside     bool
+	generation int
+	timer      *time.Timer
+	pos        fyne.Position
+	toolTip    string
+	popUp      *widget.PopUp
+}
+
+// Set hover delays for this label
+// Negative values reset to the package defaults
+func (l *ColorLabel) SetHoverDelay(enter, exit time.Duration) {
+	if enter < 0 || exit < 0 {
+		l.hover.ownDelay = false
+		return
+	}
+	l.hover.delay = enter
+	l.hover.exitDelay = exit
+	l.hover.ownDelay = true
+}
+
+// Get the hover delays used by this label
+func (l *ColorLabel) GetHoverDelay() (time.Duration, time.Duration) {
+	if l.hover.ownDelay {
+		return l.hover.delay, l.hover.exitDelay
+	}
+	return GetDefaultHoverDelay()
+}
+
+// Set colors used while the mouse hovers over the label
+// txtColor and backColor are NRGBA or fyne.ThemeColorName, nil keeps the normal color
+// Shortcut for SetStateStyle with StateHover
+func (l *ColorLabel) SetHoverColors(txtColor, backColor any) error {
+	return l.SetStateStyle(StateHover, Style{TextColor: txtColor, BackgroundColor: backColor})
+}
+
+// Set a tool tip shown while the mouse hovers over the label
+// An empty text removes the tool tip
+func (l *ColorLabel) SetToolTip(s string) {
+	l.hover.toolTip = s
+	if s == "" {
+		l.hideToolTip()
+	}
+}
+
+// Get the tool tip text
+func (l *ColorLabel) GetToolTip() string {
+	return l.hover.toolTip
+}
+
+// Reports if the hover state is currently engaged
+func (l *ColorLabel) IsHovered() bool {
+	return l.hover.hovered
+}
+
+// Hoverable interface
+func (l *ColorLabel) MouseIn(ev *desktop.MouseEvent) {
+	if l.static {
+		return
+	}
+	l.hover.inside = true
+	l.hover.pos = ev.AbsolutePosition
+	if l.hover.hovered {
+		l.stopHoverTimer()
+		return
+	}
+	enter, _ := l.GetHoverDelay()
+	l.startHoverTimer(enter, true)
+}
+
+// Hoverable interface
+func (l *ColorLabel) MouseMoved(ev *desktop.MouseEvent) {
+	if l.static {
+		return
+	}
+	l.hover.pos = ev.AbsolutePosition
+}
+
+// Hoverable interface
+func (l *ColorLabel) MouseOut() {
+	if l.static {
+		return
+	}
+	l.hover.inside = false
+	if !l.hover.hovered {
+		l.stopHoverTimer()
+		return
+	}
+	_, exit := l.GetHoverDelay()
+	l.startHoverTimer(exit, false)
+}
+
+func (l *ColorLabel) stopHoverTimer() {
+	l.hover.generation++
+	if l.hover.timer != nil {
+		l.hover.timer.Stop()
+		l.hover.timer = nil
+	}
+}
+
+func (l *ColorLabel) startHoverTimer(d time.Duration, hovered bool) {
+	l.stopHoverTimer()
+	if d <= 0 {
+		l.setHovered(hovered)
+		return
+	}
+	gen := l.hover.generation
+	l.hover.timer = time.AfterFunc(d, func() {
+		fyne.Do(func() {
+			if l.hover.generation == gen {
+				l.hover.timer = nil
+				l.setHovered(hovered)
+			}
+		})
+	})
+}
+
+func (l *ColorLabel) setHovered(hovered bool) {
+	if l.hover.hovered == hovered {
+		return
+	}
+	l.hover.hovered = hovered
+	if hovered {
+		l.showToolTip()
+	} else {
+		l.hideToolTip()
+	}
+	if _, ok := l.stateStyles[StateHover]; ok || l.mask.on && l.mask.reveal == RevealOnHover {
+		l.Refresh()
+	}
+}
+
+func (l *ColorLabel) showToolTip() {
+	text := l.toolTipText()
+	if text == "" || l.hover.popUp != nil {
+		return
+	}
+	c := fyne.CurrentApp().Driver().CanvasForObject(l)
+	if c == nil {
+		return
+	}
+	tip := NewColorLabel(text, nil, nil, 0.9)
+	l.hover.popUp = widget.NewPopUp(tip, c)
+	l.hover.popUp.ShowAtPosition(l.hover.pos.AddXY(0, 16))
+}
+
+func (l *ColorLabel) hideToolTip() {
+	if l.hover.popUp != nil {
+		l.hover.popUp.Hide()
+		l.hover.popUp = nil
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Semantic style presets which map the fyne widget.Importance values
+// to the matching theme colors.
+
+package colorlabel
+
+import (
+	"image/color"
+
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// Returns the theme color names for text and background of an importance
+func importanceColors(imp widget.Importance) (any, any) {
+	switch imp {
+	case widget.HighImportance:
+		return theme.ColorNameForegroundOnPrimary, theme.ColorNamePrimary
+	case widget.LowImportance:
+		return theme.ColorNameDisabled, color.Transparent
+	case widget.DangerImportance:
+		return theme.ColorNameForegroundOnError, theme.ColorNameError
+	case widget.WarningImportance:
+		return theme.ColorNameForegroundOnWarning, theme.ColorNameWarning
+	case widget.SuccessImportance:
+		return theme.ColorNameForegroundOnSuccess, theme.ColorNameSuccess
+	}
+	return theme.ColorNameForeground, color.Transparent
+}
+
+// Creates a new ColorLabel with colors matching the importance
+func NewImportanceLabel(s string, imp widget.Importance, tScale float32) *ColorLabel {
+	fg, bg := importanceColors(imp)
+	l := NewColorLabel(s, fg, bg, tScale)
+	l.importance = imp
+	l.colorMeaning = importanceMeaning(imp)
+	return l
+}
+
+// Creates a new ColorLabel with success colors
+func NewSuccessLabel(s string, tScale float32) *ColorLabel {
+	return NewImportanceLabel(s, widget.SuccessImportance, tScale)
+}
+
+// Creates a new ColorLabel with warning colors
+func NewWarningLabel(s string, tScale float32) *ColorLabel {
+	return NewImportanceLabel(s, widget.WarningImportance, tScale)
+}
+
+// Creates a new ColorLabel with error colors
+func NewErrorLabel(s string, tScale float32) *ColorLabel {
+	return NewImportanceLabel(s, widget.DangerImportance, tScale)
+}
+
+// Creates a new ColorLabel with info (primary) colors
+func NewInfoLabel(s string, tScale float32) *ColorLabel {
+	return NewImportanceLabel(s, widget.HighImportance, tScale)
+}
+
+// Set text and background color matching the importance
+// The color meaning is set to the importance too
+func (l *ColorLabel) SetImportance(imp widget.Importance) {
+	fg, bg := importanceColors(imp)
+	l.importance = imp
+	l.colorMeaning = importanceMeaning(imp)
+	l.fgColor = fg
+	l.bgColor = bg
+	l.Refresh()
+}
+
+// Get the last importance set
+func (l *ColorLabel) GetImportance() widget.Importance {
+	return l.importance
+}
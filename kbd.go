@@ -0,0 +1,254 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// KbdLabel renders keyboard shortcuts as key names in small bordered
+// monospace boxes, e.g. for menus and help overlays.
+
+package colorlabel
+
+import (
+	"runtime"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+const (
+	kbdTextScale    = 0.85
+	kbdCornerRadius = 3
+)
+
+var _ fyne.Widget = (*KbdLabel)(nil)
+
+// Keyboard shortcut hint, every key is drawn in a bordered box
+// Implements
+//   - fyne.Widget
+type KbdLabel struct {
+	widget.BaseWidget
+
+	keys      []string
+	separator string
+}
+
+// Creates a new KbdLabel showing the keys, e.g. "Ctrl", "Shift", "P"
+func NewKbdLabel(keys ...string) *KbdLabel {
+	l := &KbdLabel{
+		keys:      keys,
+		separator: "+",
+	}
+	l.ExtendBaseWidget(l)
+	return l
+}
+
+// Creates a new KbdLabel from a shortcut like "Ctrl+Shift+P"
+func NewKbdLabelFromString(s string) *KbdLabel {
+	return NewKbdLabel(ParseKeys(s)...)
+}
+
+// Creates a new KbdLabel for a fyne keyboard shortcut
+func NewKbdLabelFromShortcut(sc fyne.KeyboardShortcut) *KbdLabel {
+	return NewKbdLabel(ShortcutKeys(sc)...)
+}
+
+// Splits a shortcut like "Ctrl+Shift+P" into its key names
+// A trailing "+" is the plus key, e.g. "Ctrl++"
+func ParseKeys(s string) []string {
+	var keys []string
+	for s != "" {
+		i := strings.Index(s[1:], "+")
+		if i < 0 {
+			keys = append(keys, strings.TrimSpace(s))
+			break
+		}
+		keys = append(keys, strings.TrimSpace(s[:i+1]))
+		s = s[i+2:]
+	}
+	return keys
+}
+
+// Returns the key names of a fyne keyboard shortcut
+// The modifiers use the macOS symbols on darwin
+func ShortcutKeys(sc fyne.KeyboardShortcut) []string {
+	mac := runtime.GOOS == "darwin"
+	mods := []struct {
+		mod     fyne.KeyModifier
+		name    string
+		macName string
+	}{
+		{fyne.KeyModifierControl, "Ctrl", "⌃"},
+		{fyne.KeyModifierAlt, "Alt", "⌥"},
+		{fyne.KeyModifierShift, "Shift", "⇧"},
+		{fyne.KeyModifierSuper, "Super", "⌘"},
+	}
+	var keys []string
+	for _, m := range mods {
+		if sc.Mod()&m.mod == 0 {
+			continue
+		}
+		if mac {
+			keys = append(keys, m.macName)
+		} else {
+			keys = append(keys, m.name)
+		}
+	}
+	return append(keys, string(sc.Key()))
+}
+
+// Set the keys
+func (l *KbdLabel) SetKeys(keys ...string) {
+	l.keys = keys
+	l.Refresh()
+}
+
+// Get the keys
+func (l *KbdLabel) GetKeys() []string {
+	return l.keys
+}
+
+// Set the text drawn between the keys, default is "+"
+func (l *KbdLabel) SetSeparator(s string) {
+	l.separator = s
+	l.Refresh()
+}
+
+// Get the text drawn between the keys
+func (l *KbdLabel) GetSeparator() string {
+	return l.separator
+}
+
+// Widget interface
+func (l *KbdLabel) CreateRenderer() fyne.WidgetRenderer {
+	r := &kbdLabelRenderer{l: l}
+	r.Refresh()
+	return r
+}
+
+type kbdLabelRenderer struct {
+	l     *KbdLabel
+	boxes []*canvas.Rectangle
+	texts []*canvas.Text
+	seps  []*canvas.Text
+	objs  []fyne.CanvasObject
+}
+
+// Size of a key box
+func (r *kbdLabelRenderer) boxSize(t *canvas.Text) fyne.Size {
+	p := theme.Padding()
+	s := t.MinSize()
+	return fyne.NewSize(max(s.Width+2*p, s.Height), s.Height+p/2)
+}
+
+// WidgetRenderer interface
+func (r *kbdLabelRenderer) Layout(size fyne.Size) {
+	ms := r.MinSize()
+	y := (size.Height - ms.Height) / 2
+	var x float32
+	for i, t := range r.texts {
+		if i > 0 {
+			sep := r.seps[i-1]
+			ss := sep.MinSize()
+			sep.Move(fyne.NewPos(x, y+(ms.Height-ss.Height)/2))
+			sep.Resize(ss)
+			x += ss.Width
+		}
+		bs := r.boxSize(t)
+		pos := fyne.NewPos(x, y+(ms.Height-bs.Height)/2)
+		r.boxes[i].Move(pos)
+		r.boxes[i].Resize(bs)
+		t.Move(pos)
+		t.Resize(bs)
+		x += bs.Width
+	}
+}
+
+// WidgetRenderer interface
+func (r *kbdLabelRenderer) MinSize() fyne.Size {
+	var w, h float32
+	for i, t := range r.texts {
+		if i > 0 {
+			ss := r.seps[i-1].MinSize()
+			w += ss.Width
+			h = max(h, ss.Height)
+		}
+		bs := r.boxSize(t)
+		w += bs.Width
+		h = max(h, bs.Height)
+	}
+	return fyne.NewSize(w, h)
+}
+
+// WidgetRenderer interface
+func (r *kbdLabelRenderer) Refresh() {
+	keys := r.l.keys
+	for len(r.texts) < len(keys) {
+		b := canvas.NewRectangle(nil)
+		b.CornerRadius = kbdCornerRadius
+		b.StrokeWidth = 1
+		t := canvas.NewText("", nil)
+		t.Alignment = fyne.TextAlignCenter
+		t.TextStyle = fyne.TextStyle{Monospace: true}
+		r.boxes = append(r.boxes, b)
+		r.texts = append(r.texts, t)
+		if len(r.texts) > 1 {
+			r.seps = append(r.seps, canvas.NewText("", nil))
+		}
+	}
+	r.boxes = r.boxes[:len(keys)]
+	r.texts = r.texts[:len(keys)]
+	r.seps = r.seps[:max(len(keys)-1, 0)]
+
+	size := theme.TextSize() * kbdTextScale
+	fg := theme.Color(theme.ColorNameForeground)
+	r.objs = r.objs[:0]
+	for i, t := range r.texts {
+		if i > 0 {
+			sep := r.seps[i-1]
+			sep.Text = r.l.separator
+			sep.TextSize = size
+			sep.Color = theme.Color(theme.ColorNamePlaceHolder)
+			sep.Refresh()
+			r.objs = append(r.objs, sep)
+		}
+		b := r.boxes[i]
+		b.FillColor = theme.Color(theme.ColorNameInputBackground)
+		b.StrokeColor = theme.Color(theme.ColorNameInputBorder)
+		b.Refresh()
+		t.Text = keys[i]
+		t.TextSize = size
+		t.Color = fg
+		t.Refresh()
+		r.objs = append(r.objs, b, t)
+	}
+	r.Layout(r.l.Size())
+}
+
+// WidgetRenderer interface
+func (r *kbdLabelRenderer) Destroy() {
+}
+
+// WidgetRenderer interface
+func (r *kbdLabelRenderer) Objects() []fyne.CanvasObject {
+	return r.objs
+}
@@ -0,0 +1,180 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Legend for charts, color swatches with a text in a wrapping flow.
+// Series can optionally be switched on and off by tapping their entry.
+
+package colorlabel
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// size of the swatch relative to the text size
+const legendSwatchScale = 0.8
+
+var _ fyne.Widget = (*Legend)(nil)
+
+// One series of a Legend
+// Color is NRGBA or fyne.ThemeColorName
+type LegendEntry struct {
+	Color any
+	Text  string
+}
+
+// Chart legend
+// Implements
+//   - fyne.Widget
+type Legend struct {
+	widget.BaseWidget
+
+	entries    []LegendEntry
+	enabled    []bool
+	toggleable bool
+	box        *fyne.Container
+	swatches   []*canvas.Rectangle
+	labels     []*ColorLabel
+
+	// Called when an entry is switched on or off by a tap
+	OnToggled func(index int, enabled bool)
+}
+
+// Creates a new Legend, all entries are enabled
+// Returns nil if a color is not supported
+func NewLegend(entries []LegendEntry) *Legend {
+	l := &Legend{
+		box: container.New(newFlowLayout()),
+	}
+	l.ExtendBaseWidget(l)
+	if l.SetEntries(entries) != nil {
+		return nil
+	}
+	return l
+}
+
+// Widget interface
+func (l *Legend) CreateRenderer() fyne.WidgetRenderer {
+	return widget.NewSimpleRenderer(l.box)
+}
+
+// Replaces the entries, all entries are enabled
+func (l *Legend) SetEntries(entries []LegendEntry) error {
+	for _, e := range entries {
+		if err := ValidateColor(e.Color); err != nil {
+			return err
+		}
+	}
+	l.entries = entries
+	l.enabled = make([]bool, len(entries))
+	for i := range l.enabled {
+		l.enabled[i] = true
+	}
+	l.build()
+	return nil
+}
+
+// Get the entries
+func (l *Legend) GetEntries() []LegendEntry {
+	return l.entries
+}
+
+// If true a tap on an entry switches it on or off
+func (l *Legend) SetToggleable(b bool) {
+	l.toggleable = b
+	for i, label := range l.labels {
+		label.OnTapped = l.toggleFunc(i)
+	}
+}
+
+// Enables or disables an entry, disabled entries are drawn dimmed
+func (l *Legend) SetEnabled(index int, enabled bool) {
+	if index < 0 || index >= len(l.enabled) {
+		return
+	}
+	l.enabled[index] = enabled
+	l.update()
+}
+
+// Reports if an entry is enabled
+func (l *Legend) IsEnabled(index int) bool {
+	if index < 0 || index >= len(l.enabled) {
+		return false
+	}
+	return l.enabled[index]
+}
+
+// Updates the swatches and labels after a theme change
+func (l *Legend) Refresh() {
+	l.update()
+	l.BaseWidget.Refresh()
+}
+
+// Creates swatch and label of every entry
+func (l *Legend) build() {
+	objs := make([]fyne.CanvasObject, len(l.entries))
+	l.swatches = make([]*canvas.Rectangle, len(l.entries))
+	l.labels = make([]*ColorLabel, len(l.entries))
+	for i, e := range l.entries {
+		swatch := canvas.NewRectangle(nil)
+		swatch.CornerRadius = 2
+		label := NewColorLabel(e.Text, nil, nil, 1)
+		label.OnTapped = l.toggleFunc(i)
+		l.swatches[i] = swatch
+		l.labels[i] = label
+		objs[i] = container.NewHBox(container.NewCenter(swatch), label)
+	}
+	l.box.Objects = objs
+	l.update()
+	l.box.Refresh()
+}
+
+// Sets the colors of swatches and labels
+func (l *Legend) update() {
+	size := theme.TextSize() * legendSwatchScale
+	for i, e := range l.entries {
+		c, fg := e.Color, any(theme.ColorNameForeground)
+		if !l.enabled[i] {
+			c, fg = theme.ColorNameDisabled, theme.ColorNameDisabled
+		}
+		l.swatches[i].FillColor = getColor(c)
+		l.swatches[i].SetMinSize(fyne.NewSquareSize(size))
+		l.swatches[i].Refresh()
+		l.labels[i].SetTextColor(fg)
+	}
+}
+
+// Returns the tap handler of an entry, nil if the legend is not toggleable
+func (l *Legend) toggleFunc(i int) func() {
+	if !l.toggleable {
+		return nil
+	}
+	return func() {
+		l.SetEnabled(i, !l.enabled[i])
+		if l.OnToggled != nil {
+			l.OnToggled(i, l.enabled[i])
+		}
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// ListAdapter provides the callbacks of a widget.List showing a slice
+// or a binding.StringList as ColorLabels.
+
+package colorlabel
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/data/binding"
+	"fyne.io/fyne/v2/widget"
+)
+
+// Adapter between a list of strings and a widget.List with ColorLabel items
+// The items are truncated at the end and the selected item uses the theme
+// selection color. The labels are recycled by the list.
+type ListAdapter struct {
+	items    []string
+	data     binding.StringList
+	list     *widget.List
+	selected widget.ListItemID
+
+	// Returns the style of an item, nil uses the default colors
+	Style func(id widget.ListItemID, item string) Style
+	// Called when an item is selected
+	OnSelected func(id widget.ListItemID, item string)
+}
+
+// Creates a new ListAdapter for a slice of strings
+// style returns the style of an item, it may be nil
+func NewListAdapter(items []string, style func(id widget.ListItemID, item string) Style) *ListAdapter {
+	return &ListAdapter{
+		items:    items,
+		selected: -1,
+		Style:    style,
+	}
+}
+
+// Creates a new ListAdapter for a string list binding
+// style returns the style of an item, it may be nil
+func NewListAdapterWithData(data binding.StringList, style func(id widget.ListItemID, item string) Style) *ListAdapter {
+	a := &ListAdapter{
+		data:     data,
+		selected: -1,
+		Style:    style,
+	}
+	data.AddListener(binding.NewDataListener(func() {
+		if a.list != nil {
+			a.list.Refresh()
+		}
+	}))
+	return a
+}
+
+// Creates a new widget.List using the adapter
+func (a *ListAdapter) NewList() *widget.List {
+	list := widget.NewList(a.Length, a.CreateItem, a.UpdateItem)
+	a.Attach(list)
+	return list
+}
+
+// Connects the adapter to a list created with its callbacks, so a tap
+// on a label selects the item
+func (a *ListAdapter) Attach(list *widget.List) {
+	a.list = list
+	list.OnSelected = func(id widget.ListItemID) {
+		a.selected = id
+		list.RefreshItem(id)
+		if a.OnSelected != nil {
+			a.OnSelected(id, a.item(id))
+		}
+	}
+	list.OnUnselected = func(id widget.ListItemID) {
+		if a.selected == id {
+			a.selected = -1
+		}
+		list.RefreshItem(id)
+	}
+}
+
+// Set new items of a slice based adapter
+func (a *ListAdapter) SetItems(items []string) {
+	a.items = items
+	if a.list != nil {
+		a.list.Refresh()
+	}
+}
+
+// Get the items
+func (a *ListAdapter) GetItems() []string {
+	if a.data != nil {
+		items, _ := a.data.Get()
+		return items
+	}
+	return a.items
+}
+
+// Length callback of widget.List
+func (a *ListAdapter) Length() int {
+	if a.data != nil {
+		return a.data.Length()
+	}
+	return len(a.items)
+}
+
+// CreateItem callback of widget.List
+func (a *ListAdapter) CreateItem() fyne.CanvasObject {
+	l := NewColorLabel("", nil, nil, 1)
+	l.SetTruncateMode(End)
+	l.SetSelectionColor(nil)
+	return l
+}
+
+// UpdateItem callback of widget.List
+func (a *ListAdapter) UpdateItem(id widget.ListItemID, o fyne.CanvasObject) {
+	l := o.(*ColorLabel)
+	item := a.item(id)
+	var st Style
+	if a.Style != nil {
+		st = a.Style(id, item)
+	}
+	if st.Truncate == None {
+		st.Truncate = End
+	}
+	l.ApplyStyle(st)
+	l.SetSelected(id == a.selected)
+	l.SetText(item)
+	l.OnTapped = func() {
+		if a.list != nil {
+			a.list.Select(id)
+		}
+	}
+}
+
+func (a *ListAdapter) item(id widget.ListItemID) string {
+	if a.data != nil {
+		s, err := a.data.GetValue(id)
+		if err != nil {
+			return ""
+		}
+		return s
+	}
+	if id < 0 || id >= len(a.items) {
+		return ""
+	}
+	return a.items[id]
+}
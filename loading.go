@@ -0,0 +1,188 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Skeleton loading mode, the text is replaced by a shimmering
+// placeholder bar while the data is loading.
+
+package colorlabel
+
+import (
+	"image/color"
+	"math"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+
+	"github.com/bytemystery-com/colorlabel/colorutil"
+)
+
+const (
+	shimmerPeriod = 1200 * time.Millisecond
+	// width of the highlight relative to the bar
+	shimmerWidth = 0.3
+)
+
+type loadingState struct {
+	on    bool
+	width float32
+}
+
+type loadingView struct {
+	bar   *canvas.Raster
+	base  color.Color
+	shine color.Color
+	phase float32
+	anim  *fyne.Animation
+}
+
+// Replace the text by a shimmering placeholder bar while loading
+func (l *ColorLabel) SetLoading(on bool) {
+	if l.loading.on == on {
+		return
+	}
+	l.loading.on = on
+	l.Refresh()
+}
+
+// Returns true if the label is in loading mode
+func (l *ColorLabel) IsLoading() bool {
+	return l.loading.on
+}
+
+// Set the expected width of the text shown while loading
+// 0 uses the width of the current text
+func (l *ColorLabel) SetLoadingWidth(w float32) {
+	l.loading.width = max(w, 0)
+	l.Refresh()
+}
+
+// Get the expected width of the text shown while loading
+func (l *ColorLabel) GetLoadingWidth() float32 {
+	return l.loading.width
+}
+
+// Width of the placeholder bar
+func (r *ColorLabelRenderer) loadingWidth() float32 {
+	if r.w.loading.width > 0 {
+		return r.w.loading.width
+	}
+	if r.source == "" {
+		return 6 * r.text.TextSize
+	}
+	return fyne.MeasureText(r.source, r.text.TextSize, r.text.TextStyle).Width
+}
+
+func (r *ColorLabelRenderer) showLoading() {
+	if r.loading == nil {
+		v := &loadingView{phase: -shimmerWidth}
+		v.bar = canvas.NewRasterWithPixels(v.pixel)
+		r.loading = v
+		// insert in front of the caret
+		r.objs = append(r.objs[:len(r.objs)-1], v.bar, r.caret)
+	}
+	r.text.Hide()
+	if r.native != nil {
+		r.native.wrap.Hide()
+	}
+	if r.segs != nil {
+		r.segs.box.Hide()
+	}
+	if r.wrap != nil {
+		r.wrap.box.Hide()
+	}
+	if r.letters != nil {
+		r.letters.box.Hide()
+	}
+	r.w.renderedText = ""
+	r.w.truncated = false
+
+	v := r.loading
+	v.base = r.w.fadeColor(theme.Color(theme.ColorNameDisabledButton))
+	v.shine = r.w.fadeColor(colorutil.Mix(theme.Color(theme.ColorNameDisabledButton), theme.Color(theme.ColorNameBackground), 0.6))
+	w := r.loadingWidth()
+	if tw := r.textWidth(); tw > 0 {
+		w = min(w, tw)
+	}
+	h := r.text.TextSize
+	box := r.text.Size()
+	x := r.text.Position().X
+	switch r.w.alignment {
+	case fyne.TextAlignCenter:
+		x += (box.Width - w) / 2
+	case fyne.TextAlignTrailing:
+		x += box.Width - w
+	}
+	v.bar.Move(fyne.NewPos(x, r.text.Position().Y+(box.Height-h)/2))
+	v.bar.Resize(fyne.NewSize(w, h))
+	v.bar.Show()
+	v.bar.Refresh()
+	r.startLoadingAnimation()
+}
+
+func (r *ColorLabelRenderer) hideLoading() {
+	if !r.loadingShown() {
+		return
+	}
+	r.stopLoadingAnimation()
+	r.loading.bar.Hide()
+	r.text.Show()
+}
+
+// Returns true if the placeholder bar is shown
+func (r *ColorLabelRenderer) loadingShown() bool {
+	return r.loading != nil && !r.loading.bar.Hidden
+}
+
+func (r *ColorLabelRenderer) startLoadingAnimation() {
+	v := r.loading
+	if v.anim != nil || r.w.offscreen {
+		return
+	}
+	v.anim = fyne.NewAnimation(shimmerPeriod, func(f float32) {
+		v.phase = -shimmerWidth + f*(1+2*shimmerWidth)
+		v.bar.Refresh()
+	})
+	v.anim.Curve = fyne.AnimationLinear
+	v.anim.RepeatCount = fyne.AnimationRepeatForever
+	v.anim.Start()
+}
+
+func (r *ColorLabelRenderer) stopLoadingAnimation() {
+	if r.loading != nil && r.loading.anim != nil {
+		r.loading.anim.Stop()
+		r.loading.anim = nil
+	}
+}
+
+// Pixel function of the bar, a highlight moving from left to right
+func (v *loadingView) pixel(x, _, w, _ int) color.Color {
+	if w <= 0 {
+		return v.base
+	}
+	d := math.Abs(float64(x)/float64(w) - float64(v.phase))
+	if d >= shimmerWidth {
+		return v.base
+	}
+	return colorutil.Mix(v.base, v.shine, 1-d/shimmerWidth)
+}
@@ -0,0 +1,129 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Consistent severity coloring for log viewers, log levels are mapped
+// to customizable text and background colors.
+
+package colorlabel
+
+import (
+	"image/color"
+	"strings"
+	"sync"
+
+	"fyne.io/fyne/v2/theme"
+)
+
+type LogLevelType int
+
+const (
+	LevelDebug LogLevelType = iota
+	LevelInfo
+	LevelWarn
+	LevelError
+	LevelFatal
+)
+
+var (
+	logLevelNames = []string{"DEBUG", "INFO", "WARN", "ERROR", "FATAL"}
+
+	logLevelLock   sync.RWMutex
+	logLevelColors = map[LogLevelType][2]any{
+		LevelDebug: {theme.ColorNameDisabled, color.Transparent},
+		LevelInfo:  {theme.ColorNameForeground, color.Transparent},
+		LevelWarn:  {theme.ColorNameWarning, color.Transparent},
+		LevelError: {theme.ColorNameError, color.Transparent},
+		LevelFatal: {theme.ColorNameForegroundOnError, theme.ColorNameError},
+	}
+)
+
+// Returns the name of the level, e.g. "WARN"
+func (t LogLevelType) String() string {
+	if t < LevelDebug || t > LevelFatal {
+		return "UNKNOWN"
+	}
+	return logLevelNames[t]
+}
+
+// Parses a level name, case insensitive
+// "WARNING", "ERR", "TRACE" and "CRITICAL" are accepted as well
+func ParseLogLevel(s string) (LogLevelType, bool) {
+	switch strings.ToUpper(strings.TrimSpace(s)) {
+	case "TRACE", "DEBUG":
+		return LevelDebug, true
+	case "INFO":
+		return LevelInfo, true
+	case "WARN", "WARNING":
+		return LevelWarn, true
+	case "ERR", "ERROR":
+		return LevelError, true
+	case "FATAL", "CRITICAL":
+		return LevelFatal, true
+	}
+	return LevelInfo, false
+}
+
+// Set the colors used for a log level package wide
+// txtColor and backColor are NRGBA or fyne.ThemeColorName
+func SetLogLevelColors(level LogLevelType, txtColor, backColor any) error {
+	txtColor, err := normalizeTextColor(txtColor)
+	if err != nil {
+		return err
+	}
+	backColor, err = normalizeBackgroundColor(backColor)
+	if err != nil {
+		return err
+	}
+	logLevelLock.Lock()
+	defer logLevelLock.Unlock()
+	logLevelColors[level] = [2]any{txtColor, backColor}
+	return nil
+}
+
+// Get the text and background color of a log level
+func GetLogLevelColors(level LogLevelType) (any, any) {
+	logLevelLock.RLock()
+	defer logLevelLock.RUnlock()
+	c, ok := logLevelColors[level]
+	if !ok {
+		return theme.ColorNameForeground, color.Transparent
+	}
+	return c[0], c[1]
+}
+
+// Creates a new ColorLabel colored by the log level
+func NewLogLevelLabel(level LogLevelType, message string) *ColorLabel {
+	l := NewColorLabel("", nil, nil, 1)
+	l.SetLevel(level, message)
+	return l
+}
+
+// Set the message and the colors of the log level
+func (l *ColorLabel) SetLevel(level LogLevelType, message string) {
+	l.BeginUpdate()
+	defer l.EndUpdate()
+	l.fgColor, l.bgColor = GetLogLevelColors(level)
+	l.colorMeaning = strings.ToLower(level.String())
+	l.SetText(message)
+	// the colors change even if the message does not
+	l.Refresh()
+}
@@ -0,0 +1,242 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// LogView is a virtualized, scrollable list of colored log lines with
+// line retention, auto scrolling and copying of selected lines.
+
+package colorlabel
+
+import (
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/widget"
+)
+
+var _ fyne.Widget = (*LogView)(nil)
+
+type logLine struct {
+	text  string
+	style Style
+}
+
+// Scrollable list of colored lines
+// Only the visible lines are rendered, so thousands of lines stay fast.
+// Lines are selected by tapping, shift extends the selection.
+// Implements
+//   - fyne.Widget
+type LogView struct {
+	widget.BaseWidget
+
+	lines      []logLine
+	maxLines   int
+	autoScroll bool
+	list       *widget.List
+	menu       *fyne.Menu
+
+	// selected lines, -1 if nothing is selected
+	anchor  int
+	selFrom int
+	selTo   int
+}
+
+// Creates a new LogView keeping at most maxLines lines, 0 means unlimited
+func NewLogView(maxLines int) *LogView {
+	v := &LogView{
+		maxLines:   max(maxLines, 0),
+		autoScroll: true,
+		anchor:     -1,
+		selFrom:    -1,
+		selTo:      -1,
+	}
+	v.menu = fyne.NewMenu("",
+		fyne.NewMenuItem("Copy", v.Copy),
+		fyne.NewMenuItem("Select all", v.SelectAll),
+	)
+	v.list = widget.NewList(
+		func() int {
+			return len(v.lines)
+		},
+		func() fyne.CanvasObject {
+			l := NewColorLabel("", nil, nil, 1)
+			l.SetTruncateMode(End)
+			l.SetContextMenu(v.menu)
+			return l
+		},
+		v.updateItem,
+	)
+	v.ExtendBaseWidget(v)
+	return v
+}
+
+// Widget interface
+func (v *LogView) CreateRenderer() fyne.WidgetRenderer {
+	return widget.NewSimpleRenderer(v.list)
+}
+
+// Appends a line, style can be nil for the default colors
+func (v *LogView) Append(line string, style *Style) {
+	var st Style
+	if style != nil {
+		st = *style
+	}
+	if st.Truncate == None {
+		st.Truncate = End
+	}
+	v.lines = append(v.lines, logLine{text: line, style: st})
+	v.trim()
+	v.refreshList()
+}
+
+// Appends a line colored by its log level
+func (v *LogView) AppendLevel(level LogLevelType, line string) {
+	fg, bg := GetLogLevelColors(level)
+	v.Append(line, &Style{TextColor: fg, BackgroundColor: bg})
+}
+
+// Removes all lines
+func (v *LogView) Clear() {
+	v.lines = nil
+	v.clearSelection()
+	v.list.Refresh()
+}
+
+// Get the number of lines
+func (v *LogView) LineCount() int {
+	return len(v.lines)
+}
+
+// Set the maximum number of lines, the oldest lines are dropped
+// 0 means unlimited
+func (v *LogView) SetMaxLines(maxLines int) {
+	v.maxLines = max(maxLines, 0)
+	v.trim()
+	v.refreshList()
+}
+
+// Get the maximum number of lines
+func (v *LogView) GetMaxLines() int {
+	return v.maxLines
+}
+
+// If true the view scrolls to the newest line on Append
+func (v *LogView) SetAutoScroll(b bool) {
+	v.autoScroll = b
+	if b {
+		v.list.ScrollToBottom()
+	}
+}
+
+// Get the auto scroll state
+func (v *LogView) GetAutoScroll() bool {
+	return v.autoScroll
+}
+
+// Selects all lines
+func (v *LogView) SelectAll() {
+	if len(v.lines) == 0 {
+		return
+	}
+	v.anchor, v.selFrom, v.selTo = 0, 0, len(v.lines)-1
+	v.list.Refresh()
+}
+
+// Returns the selected lines joined by newlines
+func (v *LogView) SelectedText() string {
+	if v.selFrom < 0 {
+		return ""
+	}
+	var sb strings.Builder
+	for i := v.selFrom; i <= v.selTo; i++ {
+		if i > v.selFrom {
+			sb.WriteByte('\n')
+		}
+		sb.WriteString(v.lines[i].text)
+	}
+	return sb.String()
+}
+
+// Copies the selected lines to the clipboard
+func (v *LogView) Copy() {
+	if s := v.SelectedText(); s != "" {
+		fyne.CurrentApp().Clipboard().SetContent(s)
+	}
+}
+
+func (v *LogView) updateItem(id widget.ListItemID, o fyne.CanvasObject) {
+	l := o.(*ColorLabel)
+	line := v.lines[id]
+	// refresh the recycled row once
+	l.BeginUpdate()
+	l.ApplyStyle(line.style)
+	l.SetSelected(id >= v.selFrom && id <= v.selTo)
+	l.SetText(line.text)
+	l.EndUpdate()
+	l.OnTappedMod = func(_ *fyne.PointEvent, mod fyne.KeyModifier) {
+		v.tapped(id, mod&fyne.KeyModifierShift != 0)
+	}
+	l.OnTappedSecondary = func() {
+		if id < v.selFrom || id > v.selTo {
+			v.tapped(id, false)
+		}
+	}
+}
+
+func (v *LogView) tapped(id int, extend bool) {
+	if !extend || v.anchor < 0 {
+		v.anchor = id
+	}
+	v.selFrom, v.selTo = min(v.anchor, id), max(v.anchor, id)
+	v.list.Refresh()
+}
+
+func (v *LogView) clearSelection() {
+	v.anchor, v.selFrom, v.selTo = -1, -1, -1
+}
+
+// Drops the oldest lines above the limit and moves the selection along
+func (v *LogView) trim() {
+	drop := len(v.lines) - v.maxLines
+	if v.maxLines == 0 || drop <= 0 {
+		return
+	}
+	// reslicing is O(1), append copies only the kept lines when it grows the array
+	v.lines = v.lines[drop:]
+	if v.selFrom < 0 {
+		return
+	}
+	v.anchor -= drop
+	v.selFrom = max(v.selFrom-drop, 0)
+	v.selTo -= drop
+	if v.selTo < 0 {
+		v.clearSelection()
+	} else {
+		v.anchor = max(v.anchor, 0)
+	}
+}
+
+func (v *LogView) refreshList() {
+	v.list.Refresh()
+	if v.autoScroll {
+		v.list.ScrollToBottom()
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Long press support for touch devices. OnLongPressed fires while the
+// finger is still down, like the context action on Android and iOS.
+
+package colorlabel
+
+import (
+	"sync/atomic"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/driver/mobile"
+)
+
+var defaultLongPressDelay atomic.Int64
+
+func init() {
+	defaultLongPressDelay.Store(int64(500 * time.Millisecond))
+}
+
+// Set the time a touch must be held until OnLongPressed is called
+func SetLongPressDelay(d time.Duration) {
+	if d > 0 {
+		defaultLongPressDelay.Store(int64(d))
+	}
+}
+
+// Get the time a touch must be held until OnLongPressed is called
+func GetLongPressDelay() time.Duration {
+	return time.Duration(defaultLongPressDelay.Load())
+}
+
+type longPressState struct {
+	timer      *time.Timer
+	generation int
+	fired      bool
+}
+
+// Touchable interface
+func (l *ColorLabel) TouchDown(ev *mobile.TouchEvent) {
+	l.setPressed(true)
+	l.stopLongPress()
+	l.longPress.fired = false
+	if l.disabled || (l.OnLongPressed == nil && l.contextMenu == nil) {
+		return
+	}
+	gen := l.longPress.generation
+	pe := ev.PointEvent
+	l.longPress.timer = time.AfterFunc(GetLongPressDelay(), func() {
+		fyne.Do(func() {
+			if l.longPress.generation != gen {
+				return
+			}
+			l.longPress.timer = nil
+			if l.disabled {
+				return
+			}
+			l.longPress.fired = true
+			if l.OnLongPressed != nil {
+				l.OnLongPressed(&pe)
+			}
+			l.showContextMenu(&pe)
+		})
+	})
+}
+
+// Touchable interface
+func (l *ColorLabel) TouchUp(ev *mobile.TouchEvent) {
+	l.setPressed(false)
+	l.stopLongPress()
+}
+
+// Touchable interface
+func (l *ColorLabel) TouchCancel(ev *mobile.TouchEvent) {
+	l.setPressed(false)
+	l.stopLongPress()
+	l.longPress.fired = false
+}
+
+func (l *ColorLabel) stopLongPress() {
+	l.longPress.generation++
+	if l.longPress.timer != nil {
+		l.longPress.timer.Stop()
+		l.longPress.timer = nil
+	}
+}
+
+// Reports and resets if the current touch already fired OnLongPressed,
+// the driver sends a secondary tap when such a touch ends
+func (l *ColorLabel) consumeLongPress() bool {
+	fired := l.longPress.fired
+	l.longPress.fired = false
+	return fired
+}
@@ -0,0 +1,171 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Inline markdown: **bold**, *italic*, `code` and [links](url),
+// rendered with colored segments.
+
+package colorlabel
+
+import (
+	"net/url"
+	"strings"
+	"unicode"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+)
+
+// Parses inline markdown into segments
+// Supported are **bold** or __bold__, *italic* or _italic_, `code` and
+// [text](url). Markers without a closing marker are kept as text and
+// a backslash escapes the next character.
+func ParseMarkdown(s string) []Segment {
+	var segs []Segment
+	var text strings.Builder
+	var bold, italic bool
+	style := func() *fyne.TextStyle {
+		if !bold && !italic {
+			return nil
+		}
+		return &fyne.TextStyle{Bold: bold, Italic: italic}
+	}
+	flush := func() {
+		if text.Len() > 0 {
+			segs = append(segs, Segment{Text: text.String(), TextStyle: style()})
+			text.Reset()
+		}
+	}
+	r := []rune(s)
+	// reports if an unescaped closing marker follows, which is not preceded by a space
+	closes := func(from int, marker string) bool {
+		m := []rune(marker)
+		for j := from; j+len(m) <= len(r); j++ {
+			if r[j] == '\\' {
+				j++
+				continue
+			}
+			if string(r[j:j+len(m)]) == marker && j > from && !unicode.IsSpace(r[j-1]) {
+				return true
+			}
+		}
+		return false
+	}
+	opens := func(i int) bool {
+		return i < len(r) && !unicode.IsSpace(r[i])
+	}
+	afterSpace := func(i int) bool {
+		return i > 0 && unicode.IsSpace(r[i-1])
+	}
+	for i := 0; i < len(r); i++ {
+		c := r[i]
+		switch {
+		case c == '\\' && i+1 < len(r):
+			i++
+			text.WriteRune(r[i])
+		case c == '`':
+			rest := string(r[i+1:])
+			end := strings.IndexRune(rest, '`')
+			if end < 0 {
+				text.WriteRune(c)
+				continue
+			}
+			flush()
+			code := rest[:end]
+			segs = append(segs, Segment{
+				Text:            code,
+				TextStyle:       &fyne.TextStyle{Monospace: true},
+				BackgroundColor: theme.ColorNameInputBackground,
+			})
+			i += len([]rune(code)) + 1
+		case c == '[':
+			seg, n, ok := parseMarkdownLink(r[i:])
+			if !ok {
+				text.WriteRune(c)
+				continue
+			}
+			flush()
+			seg.TextStyle = style()
+			if seg.TextStyle == nil {
+				seg.TextStyle = &fyne.TextStyle{}
+			}
+			seg.TextStyle.Underline = true
+			segs = append(segs, seg)
+			i += n - 1
+		case (c == '*' || c == '_') && i+1 < len(r) && r[i+1] == c:
+			marker := string([]rune{c, c})
+			if bold && afterSpace(i) || !bold && (!opens(i+2) || !closes(i+2, marker)) {
+				text.WriteString(marker)
+				i++
+				continue
+			}
+			flush()
+			bold = !bold
+			i++
+		case c == '*' || c == '_':
+			// no italic inside words for underscores, e.g. snake_case
+			if c == '_' && !italic && i > 0 && (unicode.IsLetter(r[i-1]) || unicode.IsDigit(r[i-1])) {
+				text.WriteRune(c)
+				continue
+			}
+			if italic && afterSpace(i) || !italic && (!opens(i+1) || !closes(i+1, string(c))) {
+				text.WriteRune(c)
+				continue
+			}
+			flush()
+			italic = !italic
+		default:
+			text.WriteRune(c)
+		}
+	}
+	flush()
+	return segs
+}
+
+// Parses [text](url) at the start of r, returns the segment and the number of runes used
+func parseMarkdownLink(r []rune) (Segment, int, bool) {
+	s := string(r)
+	// the link text ends at the first bracket, "[a] b [c](d)" has a link c only
+	end := strings.IndexAny(s[1:], "[]") + 1
+	if end <= 0 || !strings.HasPrefix(s[end:], "](") {
+		return Segment{}, 0, false
+	}
+	stop := strings.IndexByte(s[end+2:], ')')
+	if stop < 0 {
+		return Segment{}, 0, false
+	}
+	u, err := url.Parse(strings.TrimSpace(s[end+2 : end+2+stop]))
+	if err != nil {
+		return Segment{}, 0, false
+	}
+	seg := Segment{
+		Text:      s[1:end],
+		TextColor: theme.ColorNameHyperlink,
+		Link:      u,
+	}
+	return seg, len([]rune(s[:end+2+stop+1])), true
+}
+
+// Set text with inline markdown, see ParseMarkdown
+// Links are tappable, see OnLinkTapped
+func (l *ColorLabel) SetMarkdown(s string) {
+	l.SetSegments(ParseMarkdown(s))
+}
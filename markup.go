@@ -0,0 +1,136 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// A small, safe markup subset for colored annotations:
+// <b>, <i>, <u> and <span color="#f00" bg="#eee">.
+
+package colorlabel
+
+import (
+	"html"
+	"regexp"
+	"strings"
+
+	"fyne.io/fyne/v2"
+)
+
+var markupAttr = regexp.MustCompile(`([a-zA-Z-]+)\s*=\s*("[^"]*"|'[^']*'|[^\s"']+)`)
+
+type markupState struct {
+	tag   string
+	fg    any
+	bg    any
+	style fyne.TextStyle
+}
+
+// Parses markup into segments
+// Supported are <b>, <i>, <u> and <span> with the attributes color and bg,
+// colors are #rgb, #rrggbb, #rrggbbaa or theme color names.
+// Entities like &lt; are decoded, other tags are removed.
+func ParseMarkup(s string) ([]Segment, error) {
+	var segs []Segment
+	stack := []markupState{{}}
+	add := func(text string) {
+		if text == "" {
+			return
+		}
+		top := stack[len(stack)-1]
+		seg := Segment{Text: html.UnescapeString(text), TextColor: top.fg, BackgroundColor: top.bg}
+		if top.style != (fyne.TextStyle{}) {
+			style := top.style
+			seg.TextStyle = &style
+		}
+		segs = append(segs, seg)
+	}
+	for {
+		i := strings.IndexByte(s, '<')
+		if i < 0 {
+			break
+		}
+		j := strings.IndexByte(s[i:], '>')
+		if j < 0 {
+			break
+		}
+		add(s[:i])
+		tag := strings.TrimSpace(s[i+1 : i+j])
+		s = s[i+j+1:]
+
+		if name, ok := strings.CutPrefix(tag, "/"); ok {
+			name = strings.ToLower(strings.TrimSpace(name))
+			for k := len(stack) - 1; k > 0; k-- {
+				if stack[k].tag == name {
+					stack = stack[:k]
+					break
+				}
+			}
+			continue
+		}
+		name, attrs, _ := strings.Cut(tag, " ")
+		name = strings.ToLower(strings.TrimSuffix(name, "/"))
+		next := stack[len(stack)-1]
+		next.tag = name
+		switch name {
+		case "b":
+			next.style.Bold = true
+		case "i":
+			next.style.Italic = true
+		case "u":
+			next.style.Underline = true
+		case "span":
+			for _, m := range markupAttr.FindAllStringSubmatch(attrs, -1) {
+				v := html.UnescapeString(strings.Trim(m[2], `"'`))
+				c, err := colorFromString(v)
+				if err == nil {
+					err = ValidateColor(c)
+				}
+				switch strings.ToLower(m[1]) {
+				case "color", "fg":
+					if err != nil {
+						return nil, err
+					}
+					next.fg = c
+				case "bg", "background":
+					if err != nil {
+						return nil, err
+					}
+					next.bg = c
+				}
+			}
+		default:
+			continue
+		}
+		if !strings.HasSuffix(tag, "/") {
+			stack = append(stack, next)
+		}
+	}
+	add(s)
+	return segs, nil
+}
+
+// Set text with markup, see ParseMarkup
+func (l *ColorLabel) SetMarkup(s string) error {
+	segs, err := ParseMarkup(s)
+	if err != nil {
+		return err
+	}
+	return l.SetSegments(segs)
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Masked mode for labels showing tokens, keys or other sensitive values.
+
+package colorlabel
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+type RevealModeType int
+
+const (
+	RevealNone RevealModeType = iota
+	RevealOnHover
+	RevealWhilePressed
+)
+
+type maskState struct {
+	on     bool
+	char   rune
+	reveal RevealModeType
+}
+
+// Set masked mode, the text is shown as bullets
+// GetText still returns the real text
+func (l *ColorLabel) SetMasked(masked bool) {
+	if l.mask.on != masked {
+		l.mask.on = masked
+		l.Refresh()
+	}
+}
+
+// Reports if masked mode is on
+func (l *ColorLabel) IsMasked() bool {
+	return l.mask.on
+}
+
+// Set the rune used for masking, 0 means '•'
+func (l *ColorLabel) SetMaskRune(r rune) {
+	if l.mask.char != r {
+		l.mask.char = r
+		if l.mask.on {
+			l.Refresh()
+		}
+	}
+}
+
+// Get the rune used for masking
+func (l *ColorLabel) GetMaskRune() rune {
+	if l.mask.char == 0 {
+		return '•'
+	}
+	return l.mask.char
+}
+
+// Set when a masked text is revealed
+// RevealOnHover shows the text while the label is hovered,
+// RevealWhilePressed while the label is pressed
+func (l *ColorLabel) SetRevealMode(mode RevealModeType) {
+	if l.mask.reveal != mode {
+		l.mask.reveal = mode
+		if l.mask.on {
+			l.Refresh()
+		}
+	}
+}
+
+// Get when a masked text is revealed
+func (l *ColorLabel) GetRevealMode() RevealModeType {
+	return l.mask.reveal
+}
+
+// Reports if the text is shown masked right now
+func (l *ColorLabel) masked() bool {
+	if !l.mask.on {
+		return false
+	}
+	switch l.mask.reveal {
+	case RevealOnHover:
+		return !l.hover.hovered
+	case RevealWhilePressed:
+		return !l.pressed
+	}
+	return true
+}
+
+func (l *ColorLabel) maskText(s string) string {
+	return strings.Repeat(string(l.GetMaskRune()), utf8.RuneCountInString(s))
+}
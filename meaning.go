@@ -0,0 +1,81 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Meaning of the colors of a label as text, e.g. "error" or "success",
+// so color coded information is not only available visually.
+
+package colorlabel
+
+import (
+	"fyne.io/fyne/v2/widget"
+)
+
+// Set the meaning of the label colors, e.g. "error" or "success"
+// The meaning is part of the accessible description,
+// it is shown in the tool tip after SetColorMeaningToolTip(true)
+func (l *ColorLabel) SetColorMeaning(meaning string) {
+	l.colorMeaning = meaning
+}
+
+// Set if the color meaning is shown in the tool tip, default is false
+func (l *ColorLabel) SetColorMeaningToolTip(show bool) {
+	l.meaningToolTip = show
+}
+
+// Get the meaning of the label colors
+func (l *ColorLabel) GetColorMeaning() string {
+	return l.colorMeaning
+}
+
+// Returns a description of the label for assistive technologies,
+// the displayed text followed by the color meaning
+func (l *ColorLabel) AccessibleDescription() string {
+	if l.colorMeaning == "" {
+		return l.displayText()
+	}
+	return l.displayText() + ", " + l.colorMeaning
+}
+
+// Returns the text of the tool tip including the color meaning if enabled
+func (l *ColorLabel) toolTipText() string {
+	switch {
+	case l.colorMeaning == "" || !l.meaningToolTip:
+		return l.hover.toolTip
+	case l.hover.toolTip == "":
+		return l.colorMeaning
+	}
+	return l.hover.toolTip + " (" + l.colorMeaning + ")"
+}
+
+func importanceMeaning(imp widget.Importance) string {
+	switch imp {
+	case widget.HighImportance:
+		return "info"
+	case widget.DangerImportance:
+		return "error"
+	case widget.WarningImportance:
+		return "warning"
+	case widget.SuccessImportance:
+		return "success"
+	}
+	return ""
+}
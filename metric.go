@@ -0,0 +1,228 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// MetricLabel shows a large value with a smaller unit and an optional
+// delta line colored by its sign, as used on dashboards.
+
+package colorlabel
+
+import (
+	"fmt"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+var _ fyne.Widget = (*MetricLabel)(nil)
+
+// Dashboard value with unit and delta line
+// Implements
+//   - fyne.Widget
+type MetricLabel struct {
+	widget.BaseWidget
+
+	value      string
+	unit       string
+	delta      string
+	deltaColor any
+	fgColor    any
+	valueScale float32
+}
+
+// Creates a new MetricLabel
+func NewMetricLabel(value, unit string) *MetricLabel {
+	m := &MetricLabel{
+		value:      value,
+		unit:       unit,
+		fgColor:    theme.ColorNameForeground,
+		valueScale: 2,
+	}
+	m.ExtendBaseWidget(m)
+	return m
+}
+
+// Widget interface
+func (m *MetricLabel) CreateRenderer() fyne.WidgetRenderer {
+	r := &metricLabelRenderer{
+		m:     m,
+		value: canvas.NewText("", nil),
+		unit:  canvas.NewText("", nil),
+		delta: canvas.NewText("", nil),
+	}
+	r.value.TextStyle = fyne.TextStyle{Bold: true}
+	r.Refresh()
+	return r
+}
+
+// Set the primary value
+func (m *MetricLabel) SetValue(value string) {
+	m.value = value
+	m.Refresh()
+}
+
+// Get the primary value
+func (m *MetricLabel) GetValue() string {
+	return m.value
+}
+
+// Set the unit shown after the value
+func (m *MetricLabel) SetUnit(unit string) {
+	m.unit = unit
+	m.Refresh()
+}
+
+// Get the unit
+func (m *MetricLabel) GetUnit() string {
+	return m.unit
+}
+
+// Set the delta in percent, e.g. 4.2 is shown as "+4.2%" in the success color
+func (m *MetricLabel) SetDelta(delta float64) {
+	m.delta = fmt.Sprintf("%+.1f%%", delta)
+	m.deltaColor = signColor(delta, 0)
+	m.Refresh()
+}
+
+// Set a preformatted delta, the color is taken from the leading sign
+func (m *MetricLabel) SetDeltaText(s string) {
+	m.delta = s
+	switch {
+	case len(s) > 0 && s[0] == '+':
+		m.deltaColor = signColor(1, 0)
+	case len(s) > 0 && s[0] == '-':
+		m.deltaColor = signColor(-1, 0)
+	default:
+		m.deltaColor = signColor(0, 0)
+	}
+	m.Refresh()
+}
+
+// Removes the delta line
+func (m *MetricLabel) ClearDelta() {
+	m.delta = ""
+	m.Refresh()
+}
+
+// Set the color of value and unit
+// txtColor is NRGBA or fyne.ThemeColorName
+func (m *MetricLabel) SetTextColor(txtColor any) error {
+	txtColor, err := normalizeTextColor(txtColor)
+	if err != nil {
+		return err
+	}
+	m.fgColor = txtColor
+	m.Refresh()
+	return nil
+}
+
+// Set the scale of the value relative to the theme text size, default is 2
+func (m *MetricLabel) SetValueScale(tScale float32) {
+	if tScale <= 0 {
+		tScale = 2
+	}
+	m.valueScale = tScale
+	m.Refresh()
+}
+
+// Returns the color for a signed value, values within +/- neutral are neutral
+func signColor(v, neutral float64) fyne.ThemeColorName {
+	switch {
+	case v > neutral:
+		return theme.ColorNameSuccess
+	case v < -neutral:
+		return theme.ColorNameError
+	}
+	return theme.ColorNameDisabled
+}
+
+type metricLabelRenderer struct {
+	m     *MetricLabel
+	value *canvas.Text
+	unit  *canvas.Text
+	delta *canvas.Text
+}
+
+// Returns size and baseline of a text, without a running app both are 0
+func textMetrics(t *canvas.Text) (fyne.Size, float32) {
+	return renderedTextSize(t.Text, t.TextSize, t.TextStyle, t.FontSource)
+}
+
+// WidgetRenderer interface
+func (r *metricLabelRenderer) Layout(size fyne.Size) {
+	p := theme.InnerPadding()
+	vs, vb := textMetrics(r.value)
+	us, ub := textMetrics(r.unit)
+	r.value.Resize(vs)
+	r.value.Move(fyne.NewPos(p, p/2))
+	r.unit.Resize(us)
+	r.unit.Move(fyne.NewPos(p+vs.Width+theme.Padding(), p/2+vb-ub))
+	r.delta.Resize(r.delta.MinSize())
+	r.delta.Move(fyne.NewPos(p, p/2+vs.Height))
+}
+
+// WidgetRenderer interface
+func (r *metricLabelRenderer) MinSize() fyne.Size {
+	p := theme.InnerPadding()
+	vs := r.value.MinSize()
+	w := vs.Width
+	if r.m.unit != "" {
+		w += theme.Padding() + r.unit.MinSize().Width
+	}
+	h := vs.Height
+	if r.m.delta != "" {
+		ds := r.delta.MinSize()
+		w = max(w, ds.Width)
+		h += ds.Height
+	}
+	return fyne.NewSize(w+2*p, h+p)
+}
+
+// WidgetRenderer interface
+func (r *metricLabelRenderer) Refresh() {
+	m := r.m
+	fg := getColor(m.fgColor)
+	r.value.Text = m.value
+	r.value.TextSize = theme.TextSize() * m.valueScale
+	r.value.Color = fg
+	r.unit.Text = m.unit
+	r.unit.TextSize = theme.TextSize()
+	r.unit.Color = fg
+	r.delta.Text = m.delta
+	r.delta.TextSize = theme.CaptionTextSize()
+	r.delta.Color = getColor(m.deltaColor)
+	r.delta.Hidden = m.delta == ""
+	r.Layout(m.Size())
+	r.value.Refresh()
+	r.unit.Refresh()
+	r.delta.Refresh()
+}
+
+// WidgetRenderer interface
+func (r *metricLabelRenderer) Destroy() {
+}
+
+// WidgetRenderer interface
+func (r *metricLabelRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.value, r.unit, r.delta}
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Live mirroring of text and style from one label to others,
+// e.g. for preview panes or compact and expanded views kept in sync.
+
+package colorlabel
+
+type mirrorTarget struct {
+	label     *ColorLabel
+	transform func(string) string
+}
+
+// Mirrors text and style of this label live to other
+// transform can change the mirrored text, nil mirrors the text unchanged
+func (l *ColorLabel) MirrorTo(other *ColorLabel, transform func(string) string) {
+	if other == nil || other == l {
+		return
+	}
+	for i, m := range l.mirrors {
+		if m.label == other {
+			l.mirrors[i].transform = transform
+			l.updateMirror(l.mirrors[i])
+			return
+		}
+	}
+	m := mirrorTarget{label: other, transform: transform}
+	l.mirrors = append(l.mirrors, m)
+	l.updateMirror(m)
+}
+
+// Stops mirroring to other
+func (l *ColorLabel) StopMirrorTo(other *ColorLabel) {
+	for i, m := range l.mirrors {
+		if m.label == other {
+			l.mirrors = append(l.mirrors[:i], l.mirrors[i+1:]...)
+			return
+		}
+	}
+}
+
+// Updates all labels this label is mirrored to
+func (l *ColorLabel) refreshMirrors() {
+	if len(l.mirrors) == 0 || l.mirroring {
+		return
+	}
+	// guard against cycles of mirrored labels
+	l.mirroring = true
+	for _, m := range l.mirrors {
+		l.updateMirror(m)
+	}
+	l.mirroring = false
+}
+
+func (l *ColorLabel) updateMirror(m mirrorTarget) {
+	t := m.label
+	t.fullText = l.fullText
+	t.segments = nil
+	if m.transform != nil {
+		t.fullText = m.transform(l.fullText)
+	} else if l.segments != nil {
+		t.setSegments(l.segments)
+	}
+	t.fgColor = l.fgColor
+	t.bgColor = l.bgColor
+	t.textScale = l.textScale
+	style := *l.textStyle
+	t.textStyle = &style
+	t.truncate = l.truncate
+	t.alignment = l.alignment
+	t.borderColor = l.borderColor
+	t.borderWidth = l.borderWidth
+	t.transform = l.transform
+	t.locale = l.locale
+	t.Refresh()
+}
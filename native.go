@@ -0,0 +1,102 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Native truncation mode: delegates truncation to fyne's widget.RichText,
+// so truncated text matches the behaviour of widget.Label exactly.
+
+package colorlabel
+
+import (
+	"image/color"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+const nativeColorName fyne.ThemeColorName = "colorlabel-native-text"
+
+// Theme wrapper providing the text color and text size of the label to the RichText
+// The padding is done by the label itself
+type nativeTheme struct {
+	fyne.Theme
+	fg       color.Color
+	textSize float32
+	padding  float32
+}
+
+func (t *nativeTheme) Color(n fyne.ThemeColorName, v fyne.ThemeVariant) color.Color {
+	if n == nativeColorName {
+		return t.fg
+	}
+	return t.Theme.Color(n, v)
+}
+
+func (t *nativeTheme) Size(n fyne.ThemeSizeName) float32 {
+	switch n {
+	case theme.SizeNameText:
+		return t.textSize
+	case theme.SizeNameInnerPadding:
+		return t.padding
+	}
+	return t.Theme.Size(n)
+}
+
+type nativeText struct {
+	rich    *widget.RichText
+	segment *widget.TextSegment
+	theme   *nativeTheme
+	wrap    *container.ThemeOverride
+}
+
+func newNativeText() *nativeText {
+	n := &nativeText{
+		segment: &widget.TextSegment{},
+		theme:   &nativeTheme{Theme: theme.Current()},
+	}
+	n.rich = widget.NewRichText(n.segment)
+	n.rich.Truncation = fyne.TextTruncateEllipsis
+	n.wrap = container.NewThemeOverride(n.rich, n.theme)
+	n.wrap.Hide()
+	return n
+}
+
+// Updates the RichText from the label, returns if the text is truncated
+func (r *ColorLabelRenderer) updateNative(fg color.Color) bool {
+	n := r.native
+	n.theme.Theme = theme.Current()
+	n.theme.fg = fg
+	n.theme.textSize = r.text.TextSize
+	n.theme.padding = 0
+	n.segment.Text = r.source
+	n.segment.Style = widget.RichTextStyle{
+		ColorName: nativeColorName,
+		SizeName:  theme.SizeNameText,
+		TextStyle: r.text.TextStyle,
+		Alignment: r.text.Alignment,
+	}
+	n.wrap.Show()
+	n.rich.Refresh()
+	w := fyne.MeasureText(r.source, r.text.TextSize, r.text.TextStyle).Width
+	return w > r.textWidth()
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Locale aware number formatting with thousands separators
+// and optional SI or binary unit scaling.
+
+package colorlabel
+
+import (
+	"math"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/number"
+)
+
+type UnitScaleType int
+
+const (
+	UnitScaleNone UnitScaleType = iota
+	// Steps of 1000 with the prefixes k, M, G, T, P, E
+	UnitScaleSI
+	// Steps of 1024 with the prefixes Ki, Mi, Gi, Ti, Pi, Ei
+	UnitScaleBinary
+)
+
+// Options for SetNumber and FormatNumber
+// Decimals is the number of digits after the decimal separator
+// Unit is appended after the scaling prefix, e.g. "B" for "3.4 MiB"
+// Locale language.Und uses the locale of the label or the system
+type NumberFormat struct {
+	Decimals   int
+	NoGrouping bool
+	Scale      UnitScaleType
+	Unit       string
+	Locale     language.Tag
+}
+
+var (
+	siPrefixes     = []string{"", "k", "M", "G", "T", "P", "E"}
+	binaryPrefixes = []string{"", "Ki", "Mi", "Gi", "Ti", "Pi", "Ei"}
+)
+
+// Formats a number with the separators of the locale, e.g. "1,234.5" or "1.234,5"
+func FormatNumber(v float64, opts NumberFormat) string {
+	tag := opts.Locale
+	if tag == language.Und {
+		tag = systemLocale()
+	}
+	prefix := ""
+	if !math.IsInf(v, 0) && !math.IsNaN(v) {
+		v, prefix = scaleNumber(v, opts.Scale)
+	}
+	dec := max(opts.Decimals, 0)
+	numOpts := []number.Option{number.MinFractionDigits(dec), number.MaxFractionDigits(dec)}
+	if opts.NoGrouping {
+		numOpts = append(numOpts, number.NoSeparator())
+	}
+	s := message.NewPrinter(tag).Sprint(number.Decimal(v, numOpts...))
+	if prefix+opts.Unit != "" {
+		s += " " + prefix + opts.Unit
+	}
+	return s
+}
+
+// Set the text to the formatted number
+// Locale language.Und of the options uses the locale of the label
+func (l *ColorLabel) SetNumber(v float64, opts NumberFormat) {
+	if opts.Locale == language.Und {
+		opts.Locale = l.GetLocale()
+	}
+	l.SetText(FormatNumber(v, opts))
+}
+
+func scaleNumber(v float64, scale UnitScaleType) (float64, string) {
+	var step float64
+	var prefixes []string
+	switch scale {
+	case UnitScaleSI:
+		step, prefixes = 1000, siPrefixes
+	case UnitScaleBinary:
+		step, prefixes = 1024, binaryPrefixes
+	default:
+		return v, ""
+	}
+	i := 0
+	for math.Abs(v) >= step && i < len(prefixes)-1 {
+		v /= step
+		i++
+	}
+	return v, prefixes[i]
+}
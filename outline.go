@@ -0,0 +1,91 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Outline (halo) around the glyphs, so light text stays readable over
+// arbitrary backgrounds or images. It is drawn by offset copies of the text.
+
+package colorlabel
+
+import (
+	"math"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+)
+
+// number of offset copies around the text
+const outlineCopies = 8
+
+type outlineState struct {
+	color any
+	width float32
+}
+
+// Draws an outline with color c and the given width around the glyphs
+// A width <= 0 removes the outline
+// The outline is drawn for plain text, not for segments or Native truncation
+// c is NRGBA or fyne.ThemeColorName
+func (l *ColorLabel) SetTextOutline(c any, width float32) error {
+	if err := ValidateColor(c); err != nil {
+		return err
+	}
+	l.outline.color = c
+	l.outline.width = max(width, 0)
+	l.Refresh()
+	return nil
+}
+
+// Get color and width of the text outline
+func (l *ColorLabel) GetTextOutline() (any, float32) {
+	return l.outline.color, l.outline.width
+}
+
+func (r *ColorLabelRenderer) layoutOutline() {
+	o := r.w.outline
+	if o.width <= 0 || o.color == nil || r.text.Hidden {
+		for _, t := range r.outline {
+			t.Hide()
+		}
+		return
+	}
+	if r.outline == nil {
+		for range outlineCopies {
+			r.outline = append(r.outline, canvas.NewText("", nil))
+		}
+		r.updateBackgroundObjects()
+	}
+	c := r.w.renderColor(o.color)
+	for i, t := range r.outline {
+		a := 2 * math.Pi * float64(i) / outlineCopies
+		dx := o.width * float32(math.Cos(a))
+		dy := o.width * float32(math.Sin(a))
+		t.Text = r.text.Text
+		t.TextSize = r.text.TextSize
+		t.TextStyle = r.text.TextStyle
+		t.Alignment = r.text.Alignment
+		t.Color = c
+		t.Move(r.text.Position().Add(fyne.NewPos(dx, dy)))
+		t.Resize(r.text.Size())
+		t.Show()
+		t.Refresh()
+	}
+}
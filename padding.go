@@ -0,0 +1,78 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Configurable padding between the background and the text of a ColorLabel.
+
+package colorlabel
+
+import (
+	"fyne.io/fyne/v2/theme"
+)
+
+// Inset between the background rectangle and the text,
+// also used for the margins around the background
+type Padding struct {
+	Top    float32
+	Right  float32
+	Bottom float32
+	Left   float32
+}
+
+// Set the padding between background and text
+// Negative values use the theme padding for that side
+func (l *ColorLabel) SetPadding(top, right, bottom, left float32) {
+	l.padding = &Padding{Top: top, Right: right, Bottom: bottom, Left: left}
+	l.Refresh()
+}
+
+// Use the theme padding on all sides again
+func (l *ColorLabel) ResetPadding() {
+	l.padding = nil
+	l.Refresh()
+}
+
+// Get the padding in effect
+func (l *ColorLabel) GetPadding() Padding {
+	pad := theme.Padding()
+	if l.padding == nil {
+		return Padding{Top: pad, Right: pad, Bottom: pad, Left: pad}
+	}
+	p := *l.padding
+	for _, v := range []*float32{&p.Top, &p.Right, &p.Bottom, &p.Left} {
+		if *v < 0 {
+			*v = pad
+		}
+	}
+	return p
+}
+
+// Set the outer spacing around the background, negative values mean 0
+// Margins are transparent, the MinSize includes them.
+func (l *ColorLabel) SetMargins(top, right, bottom, left float32) {
+	l.margins = Padding{Top: max(top, 0), Right: max(right, 0), Bottom: max(bottom, 0), Left: max(left, 0)}
+	l.Refresh()
+}
+
+// Get the outer spacing around the background
+func (l *ColorLabel) GetMargins() Padding {
+	return l.margins
+}
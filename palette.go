@@ -0,0 +1,100 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Colorblind safe categorical palette for tags, series or avatars,
+// with a deterministic mapping from a string key to an entry.
+
+package colorlabel
+
+import (
+	"hash/fnv"
+	"image/color"
+
+	"github.com/bytemystery-com/colorlabel/colorutil"
+)
+
+// Text and background color of a palette entry
+type PaletteEntry struct {
+	Foreground color.NRGBA
+	Background color.NRGBA
+}
+
+// Okabe-Ito palette without black, distinguishable with the common
+// forms of color blindness
+var paletteBase = []color.NRGBA{
+	{R: 0xe6, G: 0x9f, B: 0x00, A: 0xff}, // orange
+	{R: 0x56, G: 0xb4, B: 0xe9, A: 0xff}, // sky blue
+	{R: 0x00, G: 0x9e, B: 0x73, A: 0xff}, // bluish green
+	{R: 0xf0, G: 0xe4, B: 0x42, A: 0xff}, // yellow
+	{R: 0x00, G: 0x72, B: 0xb2, A: 0xff}, // blue
+	{R: 0xd5, G: 0x5e, B: 0x00, A: 0xff}, // vermillion
+	{R: 0xcc, G: 0x79, B: 0xa7, A: 0xff}, // reddish purple
+	{R: 0x99, G: 0x99, B: 0x99, A: 0xff}, // grey
+}
+
+// Returns n distinct palette entries
+// The first 8 entries are the base colors, further entries are
+// alternately darker and lighter variants of them
+func Palette(n int) []PaletteEntry {
+	if n <= 0 {
+		return nil
+	}
+	entries := make([]PaletteEntry, n)
+	for i := range entries {
+		entries[i] = paletteEntry(i)
+	}
+	return entries
+}
+
+// Returns the palette entry for a key, the same key always gets the same entry
+// n is the number of entries to choose from, n <= 0 uses the 8 base colors
+func PaletteFor(key string, n int) PaletteEntry {
+	if n <= 0 {
+		n = len(paletteBase)
+	}
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	return paletteEntry(int(h.Sum32() % uint32(n)))
+}
+
+// Applies the palette entry for a key as text and background color
+func (l *ColorLabel) SetPaletteKey(key string, n int) {
+	e := PaletteFor(key, n)
+	l.fgColor = e.Foreground
+	l.bgColor = e.Background
+	l.Refresh()
+}
+
+func paletteEntry(i int) PaletteEntry {
+	bg := paletteBase[i%len(paletteBase)]
+	round := i / len(paletteBase)
+	if round > 0 {
+		// 1: darker, 2: lighter, 3: darker ...
+		amount := 0.12 * float64((round+1)/2)
+		if round%2 == 1 {
+			bg = colorutil.Darken(bg, amount)
+		} else {
+			bg = colorutil.Lighten(bg, amount)
+		}
+	}
+	return PaletteEntry{Foreground: colorutil.Contrast(bg), Background: bg}
+}
@@ -0,0 +1,195 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Parser for CSS like style strings, e.g.
+// "color:#ff0000; background:error; weight:bold; size:1.2"
+
+package colorlabel
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"fyne.io/fyne/v2"
+)
+
+// Parses a CSS like style string into a Style
+// Declarations are separated by ";", property and value by ":".
+// Supported properties:
+//   - color, fg: text color, #rgb, #rrggbb, #rrggbbaa, a theme or CSS color name,
+//     "light|dark" for an AdaptiveColor
+//   - background, bg: background color
+//   - size, scale: text scale
+//   - weight: bold or normal
+//   - style: italic or normal
+//   - font: monospace, symbol or normal
+//   - decoration: underline or none
+//   - border: color and optional width, e.g. "error 2"
+//   - border-color, border-width
+//   - truncate: none, end, begin, middle or native
+//   - padding: 1, 2 or 4 values like CSS
+func ParseStyle(s string) (Style, error) {
+	var st Style
+	var ts fyne.TextStyle
+	hasTextStyle := false
+	for _, decl := range strings.Split(s, ";") {
+		decl = strings.TrimSpace(decl)
+		if decl == "" {
+			continue
+		}
+		prop, value, ok := strings.Cut(decl, ":")
+		if !ok {
+			return Style{}, fmt.Errorf("missing ':' in %q", decl)
+		}
+		prop = strings.ToLower(strings.TrimSpace(prop))
+		value = strings.TrimSpace(value)
+		lower := strings.ToLower(value)
+		var err error
+		switch prop {
+		case "color", "fg", "foreground", "text-color":
+			st.TextColor, err = parseStyleColor(value)
+		case "background", "bg", "background-color":
+			st.BackgroundColor, err = parseStyleColor(value)
+		case "size", "scale", "text-scale":
+			st.TextScale, err = parseStyleFloat(value)
+		case "weight", "font-weight":
+			hasTextStyle = true
+			ts.Bold, err = parseStyleKeyword(lower, "bold")
+		case "style", "font-style":
+			hasTextStyle = true
+			ts.Italic, err = parseStyleKeyword(lower, "italic")
+		case "decoration", "text-decoration":
+			hasTextStyle = true
+			ts.Underline, err = parseStyleKeyword(lower, "underline")
+		case "font", "font-family":
+			hasTextStyle = true
+			switch lower {
+			case "monospace", "mono":
+				ts.Monospace, ts.Symbol = true, false
+			case "symbol":
+				ts.Monospace, ts.Symbol = false, true
+			case "normal":
+				ts.Monospace, ts.Symbol = false, false
+			default:
+				err = fmt.Errorf("invalid font %q", value)
+			}
+		case "border":
+			fields := strings.Fields(value)
+			if len(fields) == 0 || len(fields) > 2 {
+				err = fmt.Errorf("invalid border %q", value)
+				break
+			}
+			st.BorderWidth = 1
+			if len(fields) == 2 {
+				st.BorderWidth, err = parseStyleFloat(fields[1])
+			}
+			if err == nil {
+				st.BorderColor, err = parseStyleColor(fields[0])
+			}
+		case "border-color":
+			st.BorderColor, err = parseStyleColor(value)
+		case "border-width":
+			st.BorderWidth, err = parseStyleFloat(value)
+		case "truncate":
+			st.Truncate, err = parseTruncateMode(lower)
+		case "padding":
+			st.Padding, err = parseStylePadding(value)
+		default:
+			err = fmt.Errorf("unknown property %q", prop)
+		}
+		if err != nil {
+			return Style{}, err
+		}
+	}
+	if hasTextStyle {
+		st.TextStyle = &ts
+	}
+	if err := validateStyle(st); err != nil {
+		return Style{}, err
+	}
+	return st, nil
+}
+
+func parseStyleColor(s string) (any, error) {
+	if s == "" {
+		return nil, errors.New("missing color")
+	}
+	return colorFromString(s)
+}
+
+func parseStyleFloat(s string) (float32, error) {
+	v, err := strconv.ParseFloat(strings.TrimSuffix(s, "px"), 32)
+	if err != nil || v < 0 {
+		return 0, fmt.Errorf("invalid number %q", s)
+	}
+	return float32(v), nil
+}
+
+// Returns true for the keyword, false for "normal" or "none"
+func parseStyleKeyword(s, keyword string) (bool, error) {
+	switch s {
+	case keyword:
+		return true, nil
+	case "normal", "none":
+		return false, nil
+	}
+	return false, fmt.Errorf("invalid value %q, expected %s or normal", s, keyword)
+}
+
+func parseTruncateMode(s string) (TruncateModeType, error) {
+	switch s {
+	case "none":
+		return None, nil
+	case "end":
+		return End, nil
+	case "begin":
+		return Begin, nil
+	case "middle":
+		return Middle, nil
+	case "native":
+		return Native, nil
+	}
+	return None, fmt.Errorf("invalid truncate mode %q", s)
+}
+
+// Parses 1, 2 or 4 values in the CSS order top, right, bottom, left
+func parseStylePadding(s string) (*Padding, error) {
+	var v []float32
+	for _, f := range strings.Fields(s) {
+		n, err := parseStyleFloat(f)
+		if err != nil {
+			return nil, err
+		}
+		v = append(v, n)
+	}
+	switch len(v) {
+	case 1:
+		return &Padding{Top: v[0], Right: v[0], Bottom: v[0], Left: v[0]}, nil
+	case 2:
+		return &Padding{Top: v[0], Right: v[1], Bottom: v[0], Left: v[1]}, nil
+	case 4:
+		return &Padding{Top: v[0], Right: v[1], Bottom: v[2], Left: v[3]}, nil
+	}
+	return nil, fmt.Errorf("invalid padding %q", s)
+}
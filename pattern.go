@@ -0,0 +1,130 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Pattern backgrounds like diagonal stripes or a checkerboard, e.g. to
+// signal a "transparent" or "deprecated" state.
+
+package colorlabel
+
+import (
+	"image/color"
+
+	"fyne.io/fyne/v2/canvas"
+)
+
+type PatternType int
+
+const (
+	PatternNone PatternType = iota
+	PatternDiagonalStripes
+	PatternHorizontalStripes
+	PatternVerticalStripes
+	PatternCheckerboard
+)
+
+// size of a stripe or a checkerboard field
+const patternSize = 6
+
+type patternState struct {
+	pattern PatternType
+	color1  any
+	color2  any
+}
+
+type patternView struct {
+	raster  *canvas.Raster
+	pattern PatternType
+	color1  color.Color
+	color2  color.Color
+	width   float32
+}
+
+// Set a pattern drawn over the background with the two colors
+// PatternNone shows the plain background again
+// The colors are NRGBA or fyne.ThemeColorName
+func (l *ColorLabel) SetBackgroundPattern(pattern PatternType, color1, color2 any) error {
+	if err := ValidateColor(color1); err != nil {
+		return err
+	}
+	if err := ValidateColor(color2); err != nil {
+		return err
+	}
+	l.pattern = patternState{pattern: pattern, color1: color1, color2: color2}
+	l.Refresh()
+	return nil
+}
+
+// Get the pattern and its colors
+func (l *ColorLabel) GetBackgroundPattern() (PatternType, any, any) {
+	return l.pattern.pattern, l.pattern.color1, l.pattern.color2
+}
+
+func (r *ColorLabelRenderer) layoutPattern() {
+	if r.w.pattern.pattern == PatternNone {
+		if r.pattern != nil {
+			r.pattern.raster.Hide()
+		}
+		return
+	}
+	if r.pattern == nil {
+		v := &patternView{}
+		v.raster = canvas.NewRasterWithPixels(v.pixel)
+		r.pattern = v
+		r.updateBackgroundObjects()
+	}
+	v := r.pattern
+	v.pattern = r.w.pattern.pattern
+	v.color1 = r.w.renderColor(r.w.pattern.color1)
+	v.color2 = r.w.renderColor(r.w.pattern.color2)
+	v.width = r.bg.Size().Width
+	v.raster.Move(r.bg.Position())
+	v.raster.Resize(r.bg.Size())
+	v.raster.Show()
+	v.raster.Refresh()
+}
+
+// Pixel function of the pattern, w is in pixels so the pattern is scaled
+func (v *patternView) pixel(x, y, w, _ int) color.Color {
+	scale := float32(1)
+	if v.width > 0 {
+		scale = float32(w) / v.width
+	}
+	n := int(patternSize * scale)
+	if n < 1 {
+		n = 1
+	}
+	var odd bool
+	switch v.pattern {
+	case PatternDiagonalStripes:
+		odd = (x+y)/n%2 == 1
+	case PatternHorizontalStripes:
+		odd = y/n%2 == 1
+	case PatternVerticalStripes:
+		odd = x/n%2 == 1
+	case PatternCheckerboard:
+		odd = (x/n+y/n)%2 == 1
+	}
+	if odd {
+		return v.color2
+	}
+	return v.color1
+}
@@ -0,0 +1,147 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// PercentChangeLabel shows a signed percentage with a trend arrow,
+// colored by its sign.
+
+package colorlabel
+
+import (
+	"fmt"
+
+	"fyne.io/fyne/v2/data/binding"
+	"fyne.io/fyne/v2/theme"
+)
+
+// Colored percentage change like "▲ +4.2%"
+// The value is given in percent. Values inside the neutral zone get no
+// arrow and the neutral color.
+type PercentChangeLabel struct {
+	ColorLabel
+
+	value        float64
+	neutral      float64
+	decimals     int
+	upColor      any
+	downColor    any
+	neutralColor any
+}
+
+// Creates a new PercentChangeLabel
+func NewPercentChangeLabel(value float64) *PercentChangeLabel {
+	p := &PercentChangeLabel{
+		decimals:     1,
+		upColor:      theme.ColorNameSuccess,
+		downColor:    theme.ColorNameError,
+		neutralColor: theme.ColorNameDisabled,
+	}
+	p.initEmbedded()
+	p.ExtendBaseWidget(p)
+	p.SetValue(value)
+	return p
+}
+
+// Creates a new PercentChangeLabel bound to data
+func NewPercentChangeLabelWithData(data binding.Float) *PercentChangeLabel {
+	p := NewPercentChangeLabel(0)
+	p.Bind(data)
+	return p
+}
+
+// Set a new value in percent
+func (p *PercentChangeLabel) SetValue(v float64) {
+	p.value = v
+	p.update()
+}
+
+// Get the value
+func (p *PercentChangeLabel) GetValue() float64 {
+	return p.value
+}
+
+// Set the neutral zone, values with abs(v) <= neutral are neutral
+func (p *PercentChangeLabel) SetNeutralZone(neutral float64) {
+	p.neutral = max(neutral, 0)
+	p.update()
+}
+
+// Set the number of decimals, default is 1
+func (p *PercentChangeLabel) SetDecimals(decimals int) {
+	p.decimals = max(decimals, 0)
+	p.update()
+}
+
+// Set the colors for rising, falling and neutral values
+// nil keeps the current color
+func (p *PercentChangeLabel) SetTrendColors(up, down, neutral any) error {
+	for _, c := range []any{up, down, neutral} {
+		if err := ValidateColor(c); err != nil {
+			return err
+		}
+	}
+	if !isDefaultColor(up) {
+		p.upColor = up
+	}
+	if !isDefaultColor(down) {
+		p.downColor = down
+	}
+	if !isDefaultColor(neutral) {
+		p.neutralColor = neutral
+	}
+	p.update()
+	return nil
+}
+
+// Binds the value to data, the label updates whenever data changes
+// Like the bindings of ColorLabel it is removed by Unbind and replaced
+// by BindFloat, BindInt or SetTemplate.
+func (p *PercentChangeLabel) Bind(data binding.Float) {
+	p.bindValue(data, func() {
+		v, err := data.Get()
+		if err != nil {
+			return
+		}
+		p.SetValue(v)
+	})
+}
+
+func (p *PercentChangeLabel) update() {
+	var c any
+	s := fmt.Sprintf("%+.*f%%", p.decimals, p.value)
+	switch signColor(p.value, p.neutral) {
+	case theme.ColorNameSuccess:
+		s = "▲ " + s
+		c = p.upColor
+	case theme.ColorNameError:
+		s = "▼ " + s
+		c = p.downColor
+	default:
+		s = fmt.Sprintf("%.*f%%", p.decimals, p.value)
+		c = p.neutralColor
+	}
+	// the color changes even if the text does not
+	p.Update(func(l *ColorLabel) {
+		l.fgColor = c
+		l.SetText(s)
+		l.Refresh()
+	})
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Color picking, a tap on the label opens a color picker dialog and the
+// chosen color is applied to the text or the background.
+
+package colorlabel
+
+import (
+	"image/color"
+
+	"fyne.io/fyne/v2/dialog"
+)
+
+type ColorTargetType int
+
+const (
+	ColorTargetNone ColorTargetType = iota
+	ColorTargetForeground
+	ColorTargetBackground
+)
+
+// A tap on the label opens a color picker for the text or background color
+// ColorTargetNone switches color picking off
+// The chosen color is reported by OnColorChanged.
+func (l *ColorLabel) EnableColorPicking(target ColorTargetType) {
+	l.pickTarget = target
+}
+
+// Get the color picking target
+func (l *ColorLabel) GetColorPicking() ColorTargetType {
+	return l.pickTarget
+}
+
+// Opens the color picker if color picking is enabled
+// Returns false if no picker is shown
+func (l *ColorLabel) pickColor() bool {
+	target := l.pickTarget
+	if target == ColorTargetNone {
+		return false
+	}
+	w := windowForObject(l)
+	if w == nil {
+		return false
+	}
+	title := "Text color"
+	current := l.fgColor
+	if target == ColorTargetBackground {
+		title = "Background color"
+		current = l.bgColor
+	}
+	p := dialog.NewColorPicker(title, "", func(c color.Color) {
+		var err error
+		if target == ColorTargetBackground {
+			err = l.SetBackgroundColor(color.NRGBAModel.Convert(c))
+		} else {
+			err = l.SetTextColor(color.NRGBAModel.Convert(c))
+		}
+		if err == nil && l.OnColorChanged != nil {
+			l.OnColorChanged(target, c)
+		}
+	}, w)
+	p.Advanced = true
+	p.SetColor(getColor(current))
+	p.Show()
+	return true
+}
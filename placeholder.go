@@ -0,0 +1,84 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Placeholder text shown instead of an empty text, e.g. "—" or "not set".
+
+package colorlabel
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+)
+
+type placeholderState struct {
+	text  string
+	style Style
+}
+
+// Set a text which is shown while the text of the label is empty
+// Only TextColor, TextScale and TextStyle of the style are used,
+// nil colors and styles mean the theme placeholder color in italic
+func (l *ColorLabel) SetPlaceholder(text string, style Style) error {
+	if err := ValidateColor(style.TextColor); err != nil {
+		return err
+	}
+	if style.TextStyle != nil {
+		s := *style.TextStyle
+		style.TextStyle = &s
+	}
+	l.placeholder = placeholderState{text: text, style: style}
+	if l.fullText == "" {
+		l.Refresh()
+	}
+	return nil
+}
+
+// Get the placeholder text and style
+func (l *ColorLabel) GetPlaceholder() (string, Style) {
+	return l.placeholder.text, l.placeholder.style
+}
+
+// Returns true if the placeholder is shown instead of the text
+func (l *ColorLabel) showsPlaceholder() bool {
+	return l.fullText == "" && l.placeholder.text != "" && l.segments == nil
+}
+
+func (l *ColorLabel) placeholderColor() any {
+	if l.placeholder.style.TextColor == nil {
+		return theme.ColorNamePlaceHolder
+	}
+	return l.placeholder.style.TextColor
+}
+
+func (l *ColorLabel) placeholderTextStyle() fyne.TextStyle {
+	if l.placeholder.style.TextStyle == nil {
+		return fyne.TextStyle{Italic: true}
+	}
+	return *l.placeholder.style.TextStyle
+}
+
+func (l *ColorLabel) placeholderTextScale() float32 {
+	if l.placeholder.style.TextScale <= 0 {
+		return l.textScale
+	}
+	return l.placeholder.style.TextScale
+}
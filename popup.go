@@ -0,0 +1,95 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Helpers for showing popups anchored to a ColorLabel.
+
+package colorlabel
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/widget"
+)
+
+type PopUpPositionType int
+
+const (
+	PopUpBelow PopUpPositionType = iota
+	PopUpAbove
+	PopUpAtTap
+)
+
+// Returns the absolute position of the label inside its canvas
+func (l *ColorLabel) AbsolutePosition() fyne.Position {
+	return fyne.CurrentApp().Driver().AbsolutePositionForObject(l)
+}
+
+// Returns the absolute position for an object of the given size anchored to the label
+// ev is only used for PopUpAtTap and may be nil otherwise
+func (l *ColorLabel) popUpPosition(size fyne.Size, where PopUpPositionType, ev *fyne.PointEvent) fyne.Position {
+	abs := l.AbsolutePosition()
+	switch where {
+	case PopUpAbove:
+		return abs.SubtractXY(0, size.Height)
+	case PopUpAtTap:
+		if ev != nil {
+			return ev.AbsolutePosition
+		}
+	}
+	return abs.AddXY(0, l.Size().Height)
+}
+
+// Shows content as popup relative to the label and returns the popup
+// ev is the tap event for PopUpAtTap, if nil the popup is shown below
+// Returns nil if the label is not shown on a canvas
+func (l *ColorLabel) ShowPopUpAt(content fyne.CanvasObject, where PopUpPositionType, ev *fyne.PointEvent) *widget.PopUp {
+	c := fyne.CurrentApp().Driver().CanvasForObject(l)
+	if c == nil {
+		return nil
+	}
+	pop := widget.NewPopUp(content, c)
+	pop.ShowAtPosition(l.popUpPosition(pop.MinSize(), where, ev))
+	return pop
+}
+
+// Shows content as popup below the label
+func (l *ColorLabel) ShowPopUpBelow(content fyne.CanvasObject) *widget.PopUp {
+	return l.ShowPopUpAt(content, PopUpBelow, nil)
+}
+
+// Shows content as popup above the label
+func (l *ColorLabel) ShowPopUpAbove(content fyne.CanvasObject) *widget.PopUp {
+	return l.ShowPopUpAt(content, PopUpAbove, nil)
+}
+
+// Returns the window showing the object, nil if it is not shown
+func windowForObject(o fyne.CanvasObject) fyne.Window {
+	c := fyne.CurrentApp().Driver().CanvasForObject(o)
+	if c == nil {
+		return nil
+	}
+	for _, w := range fyne.CurrentApp().Driver().AllWindows() {
+		if w.Canvas() == c {
+			return w
+		}
+	}
+	return nil
+}
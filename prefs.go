@@ -0,0 +1,103 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Persisting styles in the fyne preferences, so user customized label
+// colors survive app restarts.
+
+package colorlabel
+
+import (
+	"encoding/json"
+	"errors"
+
+	"fyne.io/fyne/v2"
+)
+
+type styleJSON struct {
+	TextColor       string           `json:"textColor,omitempty"`
+	BackgroundColor string           `json:"backgroundColor,omitempty"`
+	TextScale       float32          `json:"textScale,omitempty"`
+	TextStyle       *fyne.TextStyle  `json:"textStyle,omitempty"`
+	BorderColor     string           `json:"borderColor,omitempty"`
+	BorderWidth     float32          `json:"borderWidth,omitempty"`
+	Truncate        TruncateModeType `json:"truncate,omitempty"`
+	Padding         *Padding         `json:"padding,omitempty"`
+}
+
+// Stores the style as JSON string under key in the preferences
+func (s Style) SaveToPreferences(prefs fyne.Preferences, key string) error {
+	if err := validateStyle(s); err != nil {
+		return err
+	}
+	data, err := json.Marshal(styleJSON{
+		TextColor:       colorToString(s.TextColor),
+		BackgroundColor: colorToString(s.BackgroundColor),
+		TextScale:       s.TextScale,
+		TextStyle:       s.TextStyle,
+		BorderColor:     colorToString(s.BorderColor),
+		BorderWidth:     s.BorderWidth,
+		Truncate:        s.Truncate,
+		Padding:         s.Padding,
+	})
+	if err != nil {
+		return err
+	}
+	prefs.SetString(key, string(data))
+	return nil
+}
+
+// Loads a style stored by Style.SaveToPreferences
+// Returns an error if there is no style stored under key
+func LoadStyleFromPreferences(prefs fyne.Preferences, key string) (Style, error) {
+	data := prefs.String(key)
+	if data == "" {
+		return Style{}, errors.New("no style stored for " + key)
+	}
+	var j styleJSON
+	if err := json.Unmarshal([]byte(data), &j); err != nil {
+		return Style{}, err
+	}
+	s := Style{
+		TextScale:   j.TextScale,
+		TextStyle:   j.TextStyle,
+		BorderWidth: j.BorderWidth,
+		Truncate:    j.Truncate,
+		Padding:     j.Padding,
+	}
+	var err error
+	for _, c := range []struct {
+		dst *any
+		src string
+	}{
+		{&s.TextColor, j.TextColor},
+		{&s.BackgroundColor, j.BackgroundColor},
+		{&s.BorderColor, j.BorderColor},
+	} {
+		if c.src == "" {
+			continue
+		}
+		if *c.dst, err = colorFromString(c.src); err != nil {
+			return Style{}, err
+		}
+	}
+	return s, nil
+}
@@ -0,0 +1,51 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Pressed state feedback for tappable labels, like buttons have.
+
+package colorlabel
+
+// Set the background color shown while a tappable label is pressed
+// c is NRGBA or fyne.ThemeColorName, nil draws the theme pressed color over the background
+// Shortcut for SetStateStyle with StatePressed
+func (l *ColorLabel) SetPressedColor(c any) error {
+	return l.SetStateStyle(StatePressed, Style{BackgroundColor: c})
+}
+
+// Reports if the label is pressed right now
+func (l *ColorLabel) IsPressed() bool {
+	return l.pressed
+}
+
+func (l *ColorLabel) setPressed(pressed bool) {
+	if pressed && (!l.isTappable() && !l.revealsWhilePressed() || l.disabled) {
+		return
+	}
+	if l.pressed != pressed {
+		l.pressed = pressed
+		l.Refresh()
+	}
+}
+
+func (l *ColorLabel) revealsWhilePressed() bool {
+	return l.mask.on && l.mask.reveal == RevealWhilePressed
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Progress fill, the background is partially filled from the left
+// so the label shows text over a progress bar, e.g. in download lists.
+
+package colorlabel
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+)
+
+type progressState struct {
+	on       bool
+	fraction float64
+	fill     any
+	rest     any
+}
+
+// Fills the background from the left proportional to fraction (0 - 1)
+func (l *ColorLabel) SetProgress(fraction float64) {
+	l.progress.on = true
+	l.progress.fraction = min(max(fraction, 0), 1)
+	l.Refresh()
+}
+
+// Get the progress fraction, false if no progress is shown
+func (l *ColorLabel) GetProgress() (float64, bool) {
+	return l.progress.fraction, l.progress.on
+}
+
+// Removes the progress fill
+func (l *ColorLabel) ClearProgress() {
+	l.progress.on = false
+	l.Refresh()
+}
+
+// Set the colors of the filled part and the remainder
+// fill nil uses theme.ColorNameSelection, rest nil uses the background color
+// The colors are NRGBA or fyne.ThemeColorName
+func (l *ColorLabel) SetProgressColors(fill, rest any) error {
+	if err := ValidateColor(fill); err != nil {
+		return err
+	}
+	if err := ValidateColor(rest); err != nil {
+		return err
+	}
+	l.progress.fill = fill
+	l.progress.rest = rest
+	l.Refresh()
+	return nil
+}
+
+// Get the colors of the filled part and the remainder
+func (l *ColorLabel) GetProgressColors() (any, any) {
+	return l.progress.fill, l.progress.rest
+}
+
+// Returns the background color spec, the remainder color while a progress is shown
+func (l *ColorLabel) progressBackground(bg any) any {
+	if l.progress.on && l.progress.rest != nil {
+		return l.progress.rest
+	}
+	return bg
+}
+
+func (r *ColorLabelRenderer) layoutProgress() {
+	if !r.w.progress.on {
+		if r.progress != nil {
+			r.progress.Hide()
+		}
+		return
+	}
+	if r.progress == nil {
+		r.progress = canvas.NewRectangle(nil)
+		r.updateBackgroundObjects()
+	}
+	var fill any = theme.ColorNameSelection
+	if r.w.progress.fill != nil {
+		fill = r.w.progress.fill
+	}
+	size := r.bg.Size()
+	r.progress.FillColor = r.w.renderColor(fill)
+	r.progress.Move(r.bg.Position())
+	r.progress.Resize(fyne.NewSize(size.Width*float32(r.w.progress.fraction), size.Height))
+	r.progress.Show()
+	r.progress.Refresh()
+}
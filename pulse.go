@@ -0,0 +1,93 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Pulsing background, a gentle attention indicator e.g. for unsaved
+// changes which is less aggressive than Flash.
+
+package colorlabel
+
+import (
+	"image/color"
+	"time"
+
+	"fyne.io/fyne/v2"
+
+	"github.com/bytemystery-com/colorlabel/colorutil"
+)
+
+// how far the background moves toward the pulse color
+const pulseStrength = 0.6
+
+type pulseState struct {
+	color  any
+	amount float32
+	anim   *fyne.Animation
+}
+
+// Oscillates the background toward color c until StopPulse is called
+// period is the time of one full cycle
+// c is NRGBA or fyne.ThemeColorName
+func (l *ColorLabel) Pulse(c any, period time.Duration) error {
+	if err := ValidateColor(c); err != nil {
+		return err
+	}
+	l.stopPulse()
+	l.pulse.color = c
+	l.pulse.anim = fyne.NewAnimation(period/2, func(f float32) {
+		l.pulse.amount = f
+		l.Refresh()
+	})
+	l.pulse.anim.Curve = fyne.AnimationEaseInOut
+	l.pulse.anim.AutoReverse = true
+	l.pulse.anim.RepeatCount = fyne.AnimationRepeatForever
+	l.pulse.anim.Start()
+	return nil
+}
+
+// Stops the pulse animation started by Pulse
+func (l *ColorLabel) StopPulse() {
+	l.stopPulse()
+	l.Refresh()
+}
+
+// Returns true while the background is pulsing
+func (l *ColorLabel) IsPulsing() bool {
+	return l.pulse.anim != nil
+}
+
+func (l *ColorLabel) stopPulse() {
+	if l.pulse.anim != nil {
+		l.pulse.anim.Stop()
+		l.pulse.anim = nil
+	}
+	l.pulse.amount = 0
+}
+
+// Returns bg moved toward the pulse color
+func (l *ColorLabel) pulseOverlay(bg color.Color) color.Color {
+	if l.pulse.amount <= 0 {
+		return bg
+	}
+	c := color.NRGBAModel.Convert(getColor(l.pulse.color)).(color.NRGBA)
+	c.A = uint8(float32(c.A) * l.pulse.amount * pulseStrength)
+	return colorutil.Over(bg, c)
+}
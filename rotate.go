@@ -0,0 +1,173 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Vertical text orientation for sidebar tabs and axis labels.
+// The horizontal label is rendered offscreen and shown as rotated image.
+
+package colorlabel
+
+import (
+	"fmt"
+	"hash"
+	"hash/fnv"
+	"image"
+	"image/draw"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+)
+
+type OrientationType int
+
+const (
+	Horizontal OrientationType = iota
+	// Rotated clockwise, the text reads from top to bottom
+	Rotated90
+	// Rotated counterclockwise, the text reads from bottom to top
+	Rotated270
+)
+
+type rotatedView struct {
+	img   *canvas.Image
+	flat  *ColorLabel
+	objs  []fyne.CanvasObject
+	key   uint64
+	valid bool
+}
+
+// Set the orientation of the text
+// MinSize and link taps are transposed for rotated text. Positions passed to
+// the callbacks are positions in the rotated label, hover and long press
+// don't depend on the position. The caret is shown but does not blink and
+// a loading label is shown without the shimmer.
+func (l *ColorLabel) SetOrientation(o OrientationType) {
+	if l.orientation != o {
+		l.orientation = o
+		l.Refresh()
+	}
+}
+
+// Get the orientation of the text
+func (l *ColorLabel) GetOrientation() OrientationType {
+	return l.orientation
+}
+
+func (l *ColorLabel) rotated() bool {
+	return l.orientation == Rotated90 || l.orientation == Rotated270
+}
+
+func transposeSize(s fyne.Size) fyne.Size {
+	return fyne.NewSize(s.Height, s.Width)
+}
+
+// Maps a position in the label to the position in the horizontal text
+func (l *ColorLabel) flatPosition(p fyne.Position) fyne.Position {
+	s := l.Size()
+	switch l.orientation {
+	case Rotated90:
+		return fyne.NewPos(p.Y, s.Width-p.X)
+	case Rotated270:
+		return fyne.NewPos(s.Height-p.Y, p.X)
+	}
+	return p
+}
+
+// Renders a horizontal copy of the label offscreen and shows it rotated
+// The image is only rendered again if the horizontal objects changed.
+func (r *ColorLabelRenderer) renderRotated(size fyne.Size) {
+	if r.rot == nil {
+		v := &rotatedView{
+			img:  canvas.NewImageFromImage(nil),
+			flat: NewColorLabel("", nil, nil, 1),
+		}
+		v.img.FillMode = canvas.ImageFillStretch
+		v.objs = []fyne.CanvasObject{v.img}
+		r.rot = v
+	}
+	v := r.rot
+	v.img.Resize(size)
+	if size.Width <= 0 || size.Height <= 0 {
+		return
+	}
+	scale := float32(1)
+	if a := fyne.CurrentApp(); a != nil && a.Driver() != nil {
+		if c := a.Driver().CanvasForObject(r.w); c != nil {
+			scale = c.Scale()
+		}
+	}
+	h := fnv.New64a()
+	fmt.Fprint(h, size, scale, r.w.orientation, r.w.displayText(), r.w.segmentsGen, r.w.affix.gen,
+		r.w.pattern, r.w.progress, r.w.bgImage.res, r.w.bgImage.fill)
+	fingerprint(h, r.objs)
+	if v.valid && v.key == h.Sum64() {
+		return
+	}
+	v.key, v.valid = h.Sum64(), true
+	r.w.copyAppearance(v.flat)
+	v.flat.orientation = Horizontal
+	v.flat.Refresh()
+	v.img.Image = rotateImage(renderOffscreen(v.flat, transposeSize(size), scale), r.w.orientation)
+	v.img.Refresh()
+}
+
+// Writes what is drawn by the objects to h
+func fingerprint(h hash.Hash, objs []fyne.CanvasObject) {
+	for _, o := range objs {
+		fmt.Fprint(h, o.Visible(), o.Position(), o.Size())
+		switch o := o.(type) {
+		case *canvas.Text:
+			fmt.Fprint(h, o.Text, o.Color, o.TextSize, o.TextStyle)
+		case *canvas.Rectangle:
+			fmt.Fprint(h, o.FillColor, o.StrokeColor, o.StrokeWidth, o.CornerRadius)
+		case *canvas.Line:
+			fmt.Fprint(h, o.StrokeColor, o.StrokeWidth, o.Position1, o.Position2)
+		case *fyne.Container:
+			fingerprint(h, o.Objects)
+		default:
+			fmt.Fprintf(h, "%p", o)
+		}
+	}
+}
+
+// Returns the image rotated by 90 degrees clockwise or counterclockwise
+func rotateImage(img image.Image, o OrientationType) *image.NRGBA {
+	src, ok := img.(*image.NRGBA)
+	if !ok {
+		src = image.NewNRGBA(img.Bounds())
+		draw.Draw(src, src.Rect, img, img.Bounds().Min, draw.Src)
+	}
+	w, h := src.Rect.Dx(), src.Rect.Dy()
+	dst := image.NewNRGBA(image.Rect(0, 0, h, w))
+	for y := 0; y < h; y++ {
+		row := src.Pix[y*src.Stride : y*src.Stride+4*w]
+		for x := 0; x < w; x++ {
+			var i int
+			if o == Rotated90 {
+				i = x*dst.Stride + 4*(h-1-y)
+			} else {
+				i = (w-1-x)*dst.Stride + 4*y
+			}
+			copy(dst.Pix[i:i+4], row[4*x:4*x+4])
+		}
+	}
+	return dst
+}
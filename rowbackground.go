@@ -0,0 +1,208 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// RowBackground paints one ColorLabel like background (with hover and
+// selection states) behind an arbitrary row of widgets.
+
+package colorlabel
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+var (
+	_ fyne.Widget       = (*RowBackground)(nil)
+	_ fyne.Tappable     = (*RowBackground)(nil)
+	_ desktop.Hoverable = (*RowBackground)(nil)
+)
+
+// Background with hover and selection color behind a row of widgets
+// Implements
+//   - fyne.Widget
+//   - fyne.Tappable
+//   - desktop.Hoverable
+type RowBackground struct {
+	widget.BaseWidget
+
+	content        fyne.CanvasObject
+	bgColor        any
+	hoverColor     any
+	selectionColor any
+	hovered        bool
+	selected       bool
+
+	OnTapped func()
+}
+
+// Creates a new RowBackground behind content
+// backColor is NRGBA or fyne.ThemeColorName
+// Returns nil if the color is not supported
+func NewRowBackground(content fyne.CanvasObject, backColor any) *RowBackground {
+	bg, err := normalizeBackgroundColor(backColor)
+	if err != nil {
+		return nil
+	}
+	r := &RowBackground{
+		content:        content,
+		bgColor:        bg,
+		hoverColor:     theme.ColorNameHover,
+		selectionColor: theme.ColorNameSelection,
+	}
+	r.ExtendBaseWidget(r)
+	return r
+}
+
+// Widget interface
+func (r *RowBackground) CreateRenderer() fyne.WidgetRenderer {
+	bg := canvas.NewRectangle(getColor(r.currentColor()))
+	return &rowBackgroundRenderer{
+		w:    r,
+		bg:   bg,
+		objs: []fyne.CanvasObject{bg, r.content},
+	}
+}
+
+func (r *RowBackground) currentColor() any {
+	switch {
+	case r.selected:
+		return r.selectionColor
+	case r.hovered:
+		return r.hoverColor
+	}
+	return r.bgColor
+}
+
+// Set new background color
+// backColor is NRGBA or fyne.ThemeColorName
+func (r *RowBackground) SetBackgroundColor(backColor any) error {
+	bg, err := normalizeBackgroundColor(backColor)
+	if err != nil {
+		return err
+	}
+	r.bgColor = bg
+	r.Refresh()
+	return nil
+}
+
+// Set background color used while hovered
+// c is NRGBA or fyne.ThemeColorName
+func (r *RowBackground) SetHoverColor(c any) error {
+	c, err := normalizeBackgroundColor(c)
+	if err != nil {
+		return err
+	}
+	r.hoverColor = c
+	r.Refresh()
+	return nil
+}
+
+// Set background color used while selected
+// c is NRGBA or fyne.ThemeColorName
+func (r *RowBackground) SetSelectionColor(c any) error {
+	c, err := normalizeBackgroundColor(c)
+	if err != nil {
+		return err
+	}
+	r.selectionColor = c
+	r.Refresh()
+	return nil
+}
+
+// Set the selected state
+func (r *RowBackground) SetSelected(selected bool) {
+	if r.selected != selected {
+		r.selected = selected
+		r.Refresh()
+	}
+}
+
+// Reports if the row is selected
+func (r *RowBackground) IsSelected() bool {
+	return r.selected
+}
+
+// Set the hover state, e.g. if hovering is detected by a child widget
+func (r *RowBackground) SetHovered(hovered bool) {
+	if r.hovered != hovered {
+		r.hovered = hovered
+		r.Refresh()
+	}
+}
+
+// Tappable interface
+func (r *RowBackground) Tapped(ev *fyne.PointEvent) {
+	if r.OnTapped != nil {
+		r.OnTapped()
+	}
+}
+
+// Hoverable interface
+func (r *RowBackground) MouseIn(ev *desktop.MouseEvent) {
+	r.SetHovered(true)
+}
+
+// Hoverable interface
+func (r *RowBackground) MouseMoved(ev *desktop.MouseEvent) {
+}
+
+// Hoverable interface
+func (r *RowBackground) MouseOut() {
+	r.SetHovered(false)
+}
+
+type rowBackgroundRenderer struct {
+	w    *RowBackground
+	bg   *canvas.Rectangle
+	objs []fyne.CanvasObject
+}
+
+// WidgetRenderer interface
+func (r *rowBackgroundRenderer) Layout(size fyne.Size) {
+	r.bg.Resize(size)
+	r.w.content.Resize(size)
+	r.w.content.Move(fyne.NewPos(0, 0))
+}
+
+// WidgetRenderer interface
+func (r *rowBackgroundRenderer) MinSize() fyne.Size {
+	return r.w.content.MinSize()
+}
+
+// WidgetRenderer interface
+func (r *rowBackgroundRenderer) Refresh() {
+	r.bg.FillColor = getColor(r.w.currentColor())
+	r.bg.Refresh()
+	r.w.content.Refresh()
+}
+
+// WidgetRenderer interface
+func (r *rowBackgroundRenderer) Destroy() {
+}
+
+// WidgetRenderer interface
+func (r *rowBackgroundRenderer) Objects() []fyne.CanvasObject {
+	return r.objs
+}
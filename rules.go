@@ -0,0 +1,131 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Regex based coloring rules, e.g. IP addresses in blue and "FAILED"
+// in red. The rules are evaluated once per text change.
+
+package colorlabel
+
+import (
+	"regexp"
+	"slices"
+)
+
+// Coloring rule, text matching Pattern is rendered with Style
+// TextColor, BackgroundColor and TextStyle of Style are used, nil values
+// keep the label colors.
+type Rule struct {
+	Pattern *regexp.Regexp
+	Style   Style
+}
+
+type ruleState struct {
+	rules []Rule
+	text  string
+	segs  []Segment
+	valid bool
+}
+
+// Creates a new rule from a regular expression
+func NewRule(pattern string, style Style) (Rule, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return Rule{}, err
+	}
+	return Rule{Pattern: re, Style: style}, nil
+}
+
+// Set the coloring rules, earlier rules take precedence for overlapping matches
+// The rules stay active when the text changes, nil removes them.
+// Explicit segments, e.g. from SetMarkup, are shown without rules.
+func (l *ColorLabel) SetColorRules(rules []Rule) error {
+	for _, r := range rules {
+		if err := ValidateColor(r.Style.TextColor); err != nil {
+			return err
+		}
+		if err := ValidateColor(r.Style.BackgroundColor); err != nil {
+			return err
+		}
+	}
+	l.rules = ruleState{rules: slices.Clone(rules)}
+	l.Refresh()
+	return nil
+}
+
+// Get a copy of the coloring rules
+func (l *ColorLabel) GetColorRules() []Rule {
+	return slices.Clone(l.rules.rules)
+}
+
+// Returns the segments for the current text, nil if no rule matches
+func (l *ColorLabel) ruleSegments() []Segment {
+	if len(l.rules.rules) == 0 {
+		return nil
+	}
+	if l.rules.valid && l.rules.text == l.fullText {
+		return l.rules.segs
+	}
+	l.rules.text = l.fullText
+	l.rules.segs = applyRules(l.fullText, l.rules.rules)
+	l.rules.valid = true
+	l.segmentsGen++
+	return l.rules.segs
+}
+
+func applyRules(text string, rules []Rule) []Segment {
+	// rule index per byte, -1 for unmatched text
+	owner := make([]int, len(text))
+	for i := range owner {
+		owner[i] = -1
+	}
+	matched := false
+	for ri, r := range rules {
+		if r.Pattern == nil {
+			continue
+		}
+		for _, m := range r.Pattern.FindAllStringIndex(text, -1) {
+			for i := m[0]; i < m[1]; i++ {
+				if owner[i] < 0 {
+					owner[i] = ri
+					matched = true
+				}
+			}
+		}
+	}
+	if !matched {
+		return nil
+	}
+	var segs []Segment
+	start := 0
+	for i := 1; i <= len(text); i++ {
+		if i < len(text) && owner[i] == owner[start] {
+			continue
+		}
+		seg := Segment{Text: text[start:i]}
+		if owner[start] >= 0 {
+			seg = highlightSegment(seg, seg.Text, true, rules[owner[start]].Style)
+		}
+		segs = append(segs, seg)
+		start = i
+	}
+	return segs
+}
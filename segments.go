@@ -0,0 +1,357 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Segments split the label text into parts with their own colors and
+// text style. They are the base of ANSI, markup and highlight support.
+
+package colorlabel
+
+import (
+	"image/color"
+	"net/url"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+)
+
+// Part of the label text with its own colors and style
+// TextColor and BackgroundColor are NRGBA or fyne.ThemeColorName,
+// nil uses the label text color and no background.
+// TextStyle nil uses the label text style, Underline is drawn as line.
+// Segments with a Link are tappable, see OnLinkTapped.
+type Segment struct {
+	Text            string
+	TextColor       any
+	BackgroundColor any
+	TextStyle       *fyne.TextStyle
+	Link            *url.URL
+}
+
+type linkArea struct {
+	x0, x1 float32
+	link   *url.URL
+}
+
+// Set the text as colored segments
+// The full text is the concatenation of the segment texts.
+// SetText removes the segments again.
+// Only truncation at the end is supported for segments.
+func (l *ColorLabel) SetSegments(segs []Segment) error {
+	for _, s := range segs {
+		if err := ValidateColor(s.TextColor); err != nil {
+			return err
+		}
+		if err := ValidateColor(s.BackgroundColor); err != nil {
+			return err
+		}
+	}
+	l.clearTemplate()
+	l.highlightBase = nil
+	l.setSegments(segs)
+	l.Refresh()
+	return nil
+}
+
+// Get a copy of the segments, nil if the label shows plain text
+func (l *ColorLabel) GetSegments() []Segment {
+	if l.segments == nil {
+		return nil
+	}
+	segs := make([]Segment, len(l.segments))
+	copy(segs, l.segments)
+	return segs
+}
+
+func (l *ColorLabel) setSegments(segs []Segment) {
+	var sb strings.Builder
+	l.segments = make([]Segment, len(segs))
+	for i, s := range segs {
+		if s.TextStyle != nil {
+			style := *s.TextStyle
+			s.TextStyle = &style
+		}
+		l.segments[i] = s
+		sb.WriteString(s.Text)
+	}
+	l.fullText = sb.String()
+	l.segmentsGen++
+}
+
+type segmentPiece struct {
+	seg       int
+	text      string
+	style     fyne.TextStyle
+	underline bool
+	x         float32
+	size      fyne.Size
+	base      float32
+}
+
+type segmentKey struct {
+	gen       int
+	size      float32
+	style     fyne.TextStyle
+	width     float32
+	truncate  bool
+	ellipsis  string
+	transform TextTransformType
+}
+
+type segmentView struct {
+	segs   []Segment
+	box    *fyne.Container
+	key    segmentKey
+	valid  bool
+	pieces []segmentPiece
+	width  float32
+	height float32
+
+	bgs   []*canvas.Rectangle
+	texts []*canvas.Text
+	lines []*canvas.Line
+}
+
+func (r *ColorLabelRenderer) setSegmentProperties(fg color.Color, segs []Segment) {
+	if r.segs == nil {
+		r.segs = &segmentView{box: container.NewWithoutLayout()}
+		r.segs.box.Resize(r.text.Size())
+		r.segs.box.Move(r.text.Position())
+		// insert in front of the caret
+		r.objs = append(r.objs[:len(r.objs)-1], r.segs.box, r.caret)
+	}
+	if r.native != nil {
+		r.native.wrap.Hide()
+	}
+	r.text.Hide()
+	r.text.Text = r.source
+	r.text.Color = fg
+	r.segs.box.Show()
+	r.segs.segs = segs
+
+	l := r.w
+	key := segmentKey{
+		gen:       l.segmentsGen,
+		size:      r.text.TextSize,
+		style:     r.text.TextStyle,
+		width:     r.textWidth(),
+		truncate:  r.w.truncateMode() != None,
+		ellipsis:  l.ellipsis,
+		transform: l.transform,
+	}
+	if !r.segs.valid || r.segs.key != key {
+		r.measureSegments(key)
+		r.segs.key = key
+		r.segs.valid = true
+	}
+	r.buildSegments(fg)
+}
+
+// Splits the segments into pieces, truncated at the end if they do not fit
+func (r *ColorLabelRenderer) measureSegments(key segmentKey) {
+	l := r.w
+	v := r.segs
+	v.pieces = v.pieces[:0]
+	var x, total float32
+	texts := make([]string, len(v.segs))
+	styles := make([]fyne.TextStyle, len(v.segs))
+	underline := make([]bool, len(v.segs))
+	for i, s := range v.segs {
+		texts[i] = l.transformText(s.Text)
+		styles[i] = key.style
+		if s.TextStyle != nil {
+			styles[i] = *s.TextStyle
+		}
+		// the fonts do not know about underline, it is drawn as line
+		underline[i] = styles[i].Underline
+		styles[i].Underline = false
+		total += fyne.MeasureText(texts[i], key.size, styles[i]).Width
+	}
+	truncate := key.truncate && total > key.width
+	ell := l.GetEllipsis()
+	var sb strings.Builder
+	for i, t := range texts {
+		if t == "" {
+			continue
+		}
+		size, base := renderedTextSize(t, key.size, styles[i], nil)
+		if truncate {
+			ellW := fyne.MeasureText(ell, key.size, styles[i]).Width
+			if x+size.Width+ellW > key.width {
+				// cut this segment and end with the ellipsis
+				g := graphemes(t)
+				keep := len(g)
+				for ; keep > 0; keep-- {
+					if x+fyne.MeasureText(strings.Join(g[:keep], ""), key.size, styles[i]).Width+ellW <= key.width {
+						break
+					}
+				}
+				t = strings.Join(g[:keep], "") + ell
+				size, base = renderedTextSize(t, key.size, styles[i], nil)
+				v.pieces = append(v.pieces, segmentPiece{seg: i, text: t, style: styles[i], underline: underline[i], x: x, size: size, base: base})
+				sb.WriteString(t)
+				x += size.Width
+				break
+			}
+		}
+		v.pieces = append(v.pieces, segmentPiece{seg: i, text: t, style: styles[i], underline: underline[i], x: x, size: size, base: base})
+		sb.WriteString(t)
+		x += size.Width
+	}
+	v.width = x
+	plain := key.style
+	plain.Underline = false
+	v.height = fyne.MeasureText("M", key.size, plain).Height
+	for _, p := range v.pieces {
+		v.height = max(v.height, p.size.Height)
+	}
+	l.renderedText = sb.String()
+	l.truncated = truncate
+}
+
+// Places the canvas objects of the pieces, objects are reused between refreshes
+func (r *ColorLabelRenderer) buildSegments(fg color.Color) {
+	l := r.w
+	v := r.segs
+	boxSize := r.text.Size()
+	var offset float32
+	switch l.alignment {
+	case fyne.TextAlignCenter:
+		offset = (boxSize.Width - v.width) / 2
+	case fyne.TextAlignTrailing:
+		offset = boxSize.Width - v.width
+	}
+	y := (boxSize.Height - v.height) / 2
+	var nBg, nText, nLine int
+	l.links = l.links[:0]
+	for _, p := range v.pieces {
+		s := v.segs[p.seg]
+		pos := fyne.NewPos(offset+p.x, y)
+		size := fyne.NewSize(p.size.Width, v.height)
+		if s.Link != nil {
+			x := v.box.Position().X + pos.X
+			l.links = append(l.links, linkArea{x0: x, x1: x + size.Width, link: s.Link})
+		}
+		if s.BackgroundColor != nil {
+			if nBg == len(v.bgs) {
+				v.bgs = append(v.bgs, canvas.NewRectangle(nil))
+			}
+			bg := v.bgs[nBg]
+			nBg++
+			bg.FillColor = l.renderColor(s.BackgroundColor)
+			bg.Resize(size)
+			bg.Move(pos)
+		}
+		c := fg
+		if s.TextColor != nil {
+			c = l.renderColor(s.TextColor)
+		}
+		if nText == len(v.texts) {
+			v.texts = append(v.texts, canvas.NewText("", nil))
+		}
+		t := v.texts[nText]
+		nText++
+		t.Text = p.text
+		t.Color = c
+		t.TextSize = r.text.TextSize
+		t.TextStyle = p.style
+		t.Resize(size)
+		t.Move(pos)
+		if p.underline {
+			if nLine == len(v.lines) {
+				v.lines = append(v.lines, canvas.NewLine(nil))
+			}
+			line := v.lines[nLine]
+			nLine++
+			ly := y + (v.height-p.size.Height)/2 + p.base + 1
+			line.StrokeColor = c
+			line.StrokeWidth = 1
+			line.Position1 = fyne.NewPos(pos.X, ly)
+			line.Position2 = fyne.NewPos(pos.X+p.size.Width, ly)
+		}
+	}
+	objs := make([]fyne.CanvasObject, 0, nBg+nText+nLine)
+	for _, o := range v.bgs[:nBg] {
+		objs = append(objs, o)
+	}
+	for _, o := range v.texts[:nText] {
+		objs = append(objs, o)
+	}
+	for _, o := range v.lines[:nLine] {
+		objs = append(objs, o)
+	}
+	v.box.Objects = objs
+	v.box.Refresh()
+}
+
+// Hides the segments, the plain text is shown
+func (r *ColorLabelRenderer) hideSegments() {
+	if r.segs != nil {
+		r.segs.box.Hide()
+		r.text.Show()
+	}
+}
+
+// Handles a tap on a link segment, returns false if there is no link at the position
+// Links of a masked text are not tappable.
+func (l *ColorLabel) tapLink(ev *fyne.PointEvent) bool {
+	if len(l.segments) == 0 || l.masked() {
+		return false
+	}
+	pos := l.flatPosition(ev.Position)
+	for _, a := range l.links {
+		if pos.X >= a.x0 && pos.X < a.x1 {
+			if l.OnLinkTapped != nil {
+				l.OnLinkTapped(a.link)
+			} else {
+				fyne.CurrentApp().OpenURL(a.link)
+			}
+			return true
+		}
+	}
+	return false
+}
+
+func (l *ColorLabel) hasLinks() bool {
+	if l.masked() {
+		return false
+	}
+	for _, s := range l.segments {
+		if s.Link != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the segments to render, the explicit segments or the result of the color rules,
+// with prefix and suffix around them
+func (l *ColorLabel) activeSegments() []Segment {
+	switch {
+	case l.masked():
+		return l.affixSegments(nil)
+	case l.segments != nil:
+		return l.affixSegments(l.segments)
+	}
+	return l.affixSegments(l.ruleSegments())
+}
@@ -0,0 +1,250 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// JSON serialization of ColorLabel for GUI builder tools.
+// Callbacks are referenced by the names of registered actions.
+
+package colorlabel
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"image/color"
+	"strconv"
+	"strings"
+	"sync"
+
+	"fyne.io/fyne/v2"
+)
+
+var (
+	actionsLock sync.RWMutex
+	actions     = make(map[string]func())
+)
+
+type colorLabelJSON struct {
+	Text            string            `json:"text"`
+	TextColor       string            `json:"textColor,omitempty"`
+	BackgroundColor string            `json:"backgroundColor,omitempty"`
+	TextScale       float32           `json:"textScale,omitempty"`
+	TextStyle       fyne.TextStyle    `json:"textStyle"`
+	Truncate        TruncateModeType  `json:"truncate,omitempty"`
+	Alignment       fyne.TextAlign    `json:"alignment,omitempty"`
+	Transform       TextTransformType `json:"transform,omitempty"`
+	Actions         map[string]string `json:"actions,omitempty"`
+}
+
+const (
+	actionTapped          = "tapped"
+	actionTappedSecondary = "tappedSecondary"
+	actionDoubleTapped    = "doubleTapped"
+)
+
+// Registers a named action which can be referenced by serialized labels
+// A nil function removes the action
+func RegisterAction(name string, fn func()) {
+	actionsLock.Lock()
+	defer actionsLock.Unlock()
+	if fn == nil {
+		delete(actions, name)
+	} else {
+		actions[name] = fn
+	}
+}
+
+func lookupAction(name string) func() {
+	actionsLock.RLock()
+	defer actionsLock.RUnlock()
+	return actions[name]
+}
+
+func actionCaller(name string) (func(), error) {
+	if lookupAction(name) == nil {
+		return nil, fmt.Errorf("action %q is not registered", name)
+	}
+	return func() {
+		if fn := lookupAction(name); fn != nil {
+			fn()
+		}
+	}, nil
+}
+
+// Set OnTapped to the registered action with the given name
+// An empty name removes the action
+func (l *ColorLabel) SetTappedAction(name string) error {
+	fn, err := l.actionFor(name)
+	if err != nil {
+		return err
+	}
+	l.OnTapped = fn
+	l.tappedAction = name
+	return nil
+}
+
+// Set OnTappedSecondary to the registered action with the given name
+// An empty name removes the action
+func (l *ColorLabel) SetTappedSecondaryAction(name string) error {
+	fn, err := l.actionFor(name)
+	if err != nil {
+		return err
+	}
+	l.OnTappedSecondary = fn
+	l.tappedSecondaryAction = name
+	return nil
+}
+
+// Set OnDoubleTapped to the registered action with the given name
+// An empty name removes the action
+func (l *ColorLabel) SetDoubleTappedAction(name string) error {
+	fn, err := l.actionFor(name)
+	if err != nil {
+		return err
+	}
+	l.OnDoubleTapped = fn
+	l.doubleTappedAction = name
+	return nil
+}
+
+func (l *ColorLabel) actionFor(name string) (func(), error) {
+	if name == "" {
+		return nil, nil
+	}
+	return actionCaller(name)
+}
+
+// Serializes the label as JSON
+func (l *ColorLabel) ToJSON() ([]byte, error) {
+	j := colorLabelJSON{
+		Text:            l.fullText,
+		TextColor:       colorToString(l.fgColor),
+		BackgroundColor: colorToString(l.bgColor),
+		TextScale:       l.textScale,
+		TextStyle:       *l.textStyle,
+		Truncate:        l.truncate,
+		Alignment:       l.alignment,
+		Transform:       l.transform,
+	}
+	for event, name := range map[string]string{
+		actionTapped:          l.tappedAction,
+		actionTappedSecondary: l.tappedSecondaryAction,
+		actionDoubleTapped:    l.doubleTappedAction,
+	} {
+		if name == "" {
+			continue
+		}
+		if j.Actions == nil {
+			j.Actions = make(map[string]string)
+		}
+		j.Actions[event] = name
+	}
+	return json.Marshal(j)
+}
+
+// Creates a new ColorLabel from JSON created by ToJSON
+// All referenced actions must be registered before
+func FromJSON(data []byte) (*ColorLabel, error) {
+	var j colorLabelJSON
+	if err := json.Unmarshal(data, &j); err != nil {
+		return nil, err
+	}
+	fg, err := colorFromString(j.TextColor)
+	if err != nil {
+		return nil, err
+	}
+	bg, err := colorFromString(j.BackgroundColor)
+	if err != nil {
+		return nil, err
+	}
+	if j.Truncate < None || j.Truncate > Native {
+		return nil, fmt.Errorf("unknown truncate mode %d", j.Truncate)
+	}
+	l := NewColorLabel(j.Text, fg, bg, j.TextScale)
+	style := j.TextStyle
+	l.textStyle = &style
+	l.truncate = j.Truncate
+	l.alignment = j.Alignment
+	l.transform = j.Transform
+	for event, name := range j.Actions {
+		switch event {
+		case actionTapped:
+			err = l.SetTappedAction(name)
+		case actionTappedSecondary:
+			err = l.SetTappedSecondaryAction(name)
+		case actionDoubleTapped:
+			err = l.SetDoubleTappedAction(name)
+		default:
+			err = fmt.Errorf("unknown event %q", event)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return l, nil
+}
+
+// Converts a color spec into a string
+// Theme color names are kept, other colors are written as #rrggbbaa
+func colorToString(c any) string {
+	switch v := c.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case fyne.ThemeColorName:
+		return string(v)
+	case AdaptiveColor:
+		return colorToString(color.NRGBAModel.Convert(v.Light)) + "|" + colorToString(color.NRGBAModel.Convert(v.Dark))
+	case color.Color:
+		n := color.NRGBAModel.Convert(v).(color.NRGBA)
+		return fmt.Sprintf("#%02x%02x%02x%02x", n.R, n.G, n.B, n.A)
+	}
+	return ""
+}
+
+// Converts a string created by colorToString into a color spec
+// Accepts #rgb, #rrggbb, #rrggbbaa and theme color names
+// "light|dark" is an AdaptiveColor, e.g. "#000|#fff"
+func colorFromString(s string) (any, error) {
+	s = strings.TrimSpace(s)
+	if light, dark, ok := strings.Cut(s, "|"); ok {
+		return adaptiveFromStrings(light, dark)
+	}
+	if !strings.HasPrefix(s, "#") {
+		return fyne.ThemeColorName(s), nil
+	}
+	hex := s[1:]
+	if len(hex) == 3 {
+		hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+	}
+	if len(hex) == 6 {
+		hex += "ff"
+	}
+	if len(hex) != 8 {
+		return nil, errors.New("invalid color " + s)
+	}
+	v, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return nil, errors.New("invalid color " + s)
+	}
+	return color.NRGBA{R: uint8(v >> 24), G: uint8(v >> 16), B: uint8(v >> 8), A: uint8(v)}, nil
+}
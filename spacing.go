@@ -0,0 +1,194 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Line spacing for wrapped text and letter spacing for single line text,
+// e.g. for headings. canvas.Text supports neither, so the lines and
+// letters are placed as separate text objects.
+
+package colorlabel
+
+import (
+	"image/color"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+)
+
+type lettersView struct {
+	box     *fyne.Container
+	letters []*canvas.Text
+	width   float32
+	full    float32
+	// x of the shown letters and the end of the last one
+	xs []float32
+	// number of graphemes of the text which are shown
+	kept int
+}
+
+// Set the distance of wrapped lines as multiple of the line height, values <= 0 mean 1
+func (l *ColorLabel) SetLineSpacing(multiplier float32) {
+	if multiplier <= 0 {
+		multiplier = 0
+	}
+	if l.lineSpacing != multiplier {
+		l.lineSpacing = multiplier
+		l.Refresh()
+	}
+}
+
+// Get the distance of wrapped lines as multiple of the line height
+func (l *ColorLabel) GetLineSpacing() float32 {
+	if l.lineSpacing <= 0 {
+		return 1
+	}
+	return l.lineSpacing
+}
+
+// Set additional space between the letters in pixels, negative values move them closer
+// Letter spacing is used for text in a single line without segments.
+// The letters are placed one by one, kerning between them is lost and
+// End truncation is used for all truncate modes except None.
+func (l *ColorLabel) SetLetterSpacing(px float32) {
+	if l.letterSpacing != px {
+		l.letterSpacing = px
+		l.Refresh()
+	}
+}
+
+// Get the additional space between the letters in pixels
+func (l *ColorLabel) GetLetterSpacing() float32 {
+	return l.letterSpacing
+}
+
+// Returns the width of the graphemes with letter spacing
+func spacedWidth(g []string, spacing, size float32, style fyne.TextStyle) float32 {
+	if len(g) == 0 {
+		return 0
+	}
+	var w float32
+	for _, c := range g {
+		w += fyne.MeasureText(c, size, style).Width
+	}
+	return w + spacing*float32(len(g)-1)
+}
+
+func (r *ColorLabelRenderer) setSpacedTextProperties(fg color.Color) {
+	if r.letters == nil {
+		r.letters = &lettersView{box: container.NewWithoutLayout()}
+		r.letters.box.Resize(r.text.Size())
+		r.letters.box.Move(r.text.Position())
+		// insert in front of the caret
+		r.objs = append(r.objs[:len(r.objs)-1], r.letters.box, r.caret)
+	}
+	if r.native != nil {
+		r.native.wrap.Hide()
+	}
+	r.text.Hide()
+	r.text.Text = r.source
+	r.text.Color = fg
+	r.measuredValid = false
+	r.letters.box.Show()
+
+	size := r.text.TextSize
+	style := r.text.TextStyle
+	spacing := r.w.letterSpacing
+	width := r.textWidth()
+	g := graphemes(r.source)
+	r.letters.full = spacedWidth(g, spacing, size, style)
+	truncated := false
+	r.letters.kept = len(g)
+	if r.w.truncateMode() != None && width > 0 && r.letters.full > width {
+		// cut at the end, the ellipsis is one more letter
+		ell := r.w.GetEllipsis()
+		keep := len(g)
+		for ; keep > 0; keep-- {
+			if spacedWidth(append(g[:keep:keep], ell), spacing, size, style) <= width {
+				break
+			}
+		}
+		for keep > 0 && g[keep-1] == " " {
+			keep--
+		}
+		g = append(g[:keep:keep], ell)
+		r.letters.kept = keep
+		truncated = true
+	}
+	r.w.renderedText = strings.Join(g, "")
+	r.w.truncated = truncated
+	r.letters.width = spacedWidth(g, spacing, size, style)
+
+	for len(r.letters.letters) < len(g) {
+		t := canvas.NewText("", fg)
+		r.letters.letters = append(r.letters.letters, t)
+		r.letters.box.Add(t)
+	}
+	boxSize := r.text.Size()
+	var x float32
+	switch r.text.Alignment {
+	case fyne.TextAlignCenter:
+		x = (boxSize.Width - r.letters.width) / 2
+	case fyne.TextAlignTrailing:
+		x = boxSize.Width - r.letters.width
+	}
+	r.letters.xs = append(r.letters.xs[:0], x)
+	for i, t := range r.letters.letters {
+		if i >= len(g) {
+			t.Hide()
+			continue
+		}
+		w := fyne.MeasureText(g[i], size, style).Width
+		if i > 0 {
+			r.letters.xs = append(r.letters.xs, x)
+		}
+		t.Text = g[i]
+		t.Color = fg
+		t.TextSize = size
+		t.TextStyle = style
+		t.Alignment = fyne.TextAlignLeading
+		t.Move(fyne.NewPos(x, 0))
+		t.Resize(fyne.NewSize(w, boxSize.Height))
+		t.Show()
+		t.Refresh()
+		x += w + spacing
+		if i == len(g)-1 {
+			r.letters.xs = append(r.letters.xs, x-spacing)
+		}
+	}
+}
+
+func (r *ColorLabelRenderer) hideSpaced() {
+	if r.letters != nil {
+		r.letters.box.Hide()
+		r.text.Show()
+	}
+}
+
+// Returns the minimum size of the spaced text without padding
+func (r *ColorLabelRenderer) spacedMinSize() fyne.Size {
+	w := r.letters.width
+	if r.w.sizeToContent {
+		w = r.letters.full
+	}
+	return fyne.NewSize(w, r.text.MinSize().Height)
+}
@@ -0,0 +1,244 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// SplitLabel is a two tone pill with a left and a right part, each with
+// its own colors, e.g. "build | passing" badges.
+
+package colorlabel
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+var _ fyne.Widget = (*SplitLabel)(nil)
+
+// One part of a SplitLabel
+type splitPart struct {
+	text    string
+	fgColor any
+	bgColor any
+}
+
+// Two tone pill with a shared border
+// Implements
+//   - fyne.Widget
+type SplitLabel struct {
+	widget.BaseWidget
+
+	left        splitPart
+	right       splitPart
+	borderColor any
+	borderWidth float32
+	textScale   float32
+}
+
+// Creates a new SplitLabel, the left part uses the button colors
+// and the right part the primary colors
+func NewSplitLabel(left, right string) *SplitLabel {
+	l := &SplitLabel{
+		left:      splitPart{text: left, fgColor: theme.ColorNameForeground, bgColor: theme.ColorNameButton},
+		right:     splitPart{text: right, fgColor: theme.ColorNameForegroundOnPrimary, bgColor: theme.ColorNamePrimary},
+		textScale: 0.9,
+	}
+	l.ExtendBaseWidget(l)
+	return l
+}
+
+// Set new text of the left part
+func (l *SplitLabel) SetLeft(s string) {
+	l.left.text = s
+	l.Refresh()
+}
+
+// Get the text of the left part
+func (l *SplitLabel) GetLeft() string {
+	return l.left.text
+}
+
+// Set new text of the right part
+func (l *SplitLabel) SetRight(s string) {
+	l.right.text = s
+	l.Refresh()
+}
+
+// Get the text of the right part
+func (l *SplitLabel) GetRight() string {
+	return l.right.text
+}
+
+// Set text and background color of the left part
+// The colors are NRGBA or fyne.ThemeColorName
+func (l *SplitLabel) SetLeftColors(txtColor, backColor any) error {
+	return l.setColors(&l.left, txtColor, backColor)
+}
+
+// Set text and background color of the right part
+// The colors are NRGBA or fyne.ThemeColorName
+func (l *SplitLabel) SetRightColors(txtColor, backColor any) error {
+	return l.setColors(&l.right, txtColor, backColor)
+}
+
+// Set the border around both parts, a width of 0 removes the border
+// c is NRGBA or fyne.ThemeColorName
+func (l *SplitLabel) SetBorder(c any, width float32) error {
+	if err := ValidateColor(c); err != nil {
+		return err
+	}
+	l.borderColor = c
+	l.borderWidth = max(width, 0)
+	l.Refresh()
+	return nil
+}
+
+// Set new text scale
+func (l *SplitLabel) SetTextScale(tScale float32) {
+	if tScale > 0 {
+		l.textScale = tScale
+		l.Refresh()
+	}
+}
+
+func (l *SplitLabel) setColors(p *splitPart, txtColor, backColor any) error {
+	fg, err := normalizeTextColor(txtColor)
+	if err != nil {
+		return err
+	}
+	bg, err := normalizeBackgroundColor(backColor)
+	if err != nil {
+		return err
+	}
+	p.fgColor = fg
+	p.bgColor = bg
+	l.Refresh()
+	return nil
+}
+
+// Widget interface
+func (l *SplitLabel) CreateRenderer() fyne.WidgetRenderer {
+	r := &splitLabelRenderer{
+		l:         l,
+		leftBg:    canvas.NewRectangle(nil),
+		rightBg:   canvas.NewRectangle(nil),
+		leftText:  canvas.NewText("", nil),
+		rightText: canvas.NewText("", nil),
+		border:    canvas.NewRectangle(nil),
+	}
+	r.leftText.Alignment = fyne.TextAlignCenter
+	r.rightText.Alignment = fyne.TextAlignCenter
+	r.objs = []fyne.CanvasObject{r.leftBg, r.rightBg, r.leftText, r.rightText, r.border}
+	r.Refresh()
+	return r
+}
+
+type splitLabelRenderer struct {
+	l         *SplitLabel
+	leftBg    *canvas.Rectangle
+	rightBg   *canvas.Rectangle
+	leftText  *canvas.Text
+	rightText *canvas.Text
+	border    *canvas.Rectangle
+	objs      []fyne.CanvasObject
+}
+
+// Width of a part including its padding
+func (r *splitLabelRenderer) partWidth(t *canvas.Text) float32 {
+	return t.MinSize().Width + 2*theme.InnerPadding()
+}
+
+// WidgetRenderer interface
+func (r *splitLabelRenderer) Layout(size fyne.Size) {
+	ms := r.MinSize()
+	h := ms.Height
+	y := (size.Height - h) / 2
+	lw := r.partWidth(r.leftText)
+	rw := r.partWidth(r.rightText)
+	// extra space is shared by both parts
+	if extra := size.Width - ms.Width; extra > 0 {
+		lw += extra / 2
+		rw += extra / 2
+	}
+	radius := h / 2
+	r.leftBg.TopLeftCornerRadius = radius
+	r.leftBg.BottomLeftCornerRadius = radius
+	r.rightBg.TopRightCornerRadius = radius
+	r.rightBg.BottomRightCornerRadius = radius
+	r.border.CornerRadius = radius
+
+	r.leftBg.Move(fyne.NewPos(0, y))
+	r.leftBg.Resize(fyne.NewSize(lw, h))
+	r.leftText.Move(fyne.NewPos(0, y))
+	r.leftText.Resize(fyne.NewSize(lw, h))
+	r.rightBg.Move(fyne.NewPos(lw, y))
+	r.rightBg.Resize(fyne.NewSize(rw, h))
+	r.rightText.Move(fyne.NewPos(lw, y))
+	r.rightText.Resize(fyne.NewSize(rw, h))
+	r.border.Move(fyne.NewPos(0, y))
+	r.border.Resize(fyne.NewSize(lw+rw, h))
+}
+
+// WidgetRenderer interface
+func (r *splitLabelRenderer) MinSize() fyne.Size {
+	h := max(r.leftText.MinSize().Height, r.rightText.MinSize().Height) + theme.Padding()
+	return fyne.NewSize(r.partWidth(r.leftText)+r.partWidth(r.rightText), h)
+}
+
+// WidgetRenderer interface
+func (r *splitLabelRenderer) Refresh() {
+	l := r.l
+	size := theme.TextSize() * l.textScale
+	for _, p := range []struct {
+		part *splitPart
+		text *canvas.Text
+		bg   *canvas.Rectangle
+	}{
+		{&l.left, r.leftText, r.leftBg},
+		{&l.right, r.rightText, r.rightBg},
+	} {
+		p.text.Text = p.part.text
+		p.text.TextSize = size
+		p.text.Color = getColor(p.part.fgColor)
+		p.text.Refresh()
+		p.bg.FillColor = getColor(p.part.bgColor)
+		p.bg.Refresh()
+	}
+	if l.borderWidth > 0 && l.borderColor != nil {
+		r.border.StrokeColor = getColor(l.borderColor)
+		r.border.StrokeWidth = l.borderWidth
+		r.border.Show()
+	} else {
+		r.border.Hide()
+	}
+	r.border.Refresh()
+	r.Layout(l.Size())
+}
+
+// WidgetRenderer interface
+func (r *splitLabelRenderer) Destroy() {
+}
+
+// WidgetRenderer interface
+func (r *splitLabelRenderer) Objects() []fyne.CanvasObject {
+	return r.objs
+}
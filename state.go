@@ -0,0 +1,224 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Interaction states of a ColorLabel and the styles used for them.
+// The style of the current state is laid over the normal style in the
+// order hover, selected, pressed and disabled.
+
+package colorlabel
+
+import (
+	"fyne.io/fyne/v2/theme"
+
+	"github.com/bytemystery-com/colorlabel/colorutil"
+)
+
+type StateType int
+
+const (
+	StateNormal StateType = iota
+	StateHover
+	StatePressed
+	StateDisabled
+	StateSelected
+)
+
+// Set the style used for a state
+// Only text color, background color and border of the style are used, nil and "" colors keep the color of the normal state
+// For StateNormal the colors and the border of the label are set, nil keeps a color and "" uses the default color
+func (l *ColorLabel) SetStateStyle(state StateType, style Style) error {
+	if err := validateStyle(style); err != nil {
+		return err
+	}
+	if state == StateNormal {
+		if style.TextColor != nil {
+			l.fgColor, _ = normalizeTextColor(style.TextColor)
+		}
+		if style.BackgroundColor != nil {
+			l.bgColor, _ = normalizeBackgroundColor(style.BackgroundColor)
+		}
+		l.borderColor = style.BorderColor
+		l.borderWidth = style.BorderWidth
+	} else {
+		if l.stateStyles == nil {
+			l.stateStyles = make(map[StateType]Style)
+		}
+		l.stateStyles[state] = style
+	}
+	l.Refresh()
+	return nil
+}
+
+// Get the style used for a state and if one is set
+func (l *ColorLabel) GetStateStyle(state StateType) (Style, bool) {
+	if state == StateNormal {
+		s := l.GetStyle()
+		return s, true
+	}
+	s, ok := l.stateStyles[state]
+	return s, ok
+}
+
+// Removes the style of a state
+func (l *ColorLabel) RemoveStateStyle(state StateType) {
+	if _, ok := l.stateStyles[state]; ok {
+		delete(l.stateStyles, state)
+		l.Refresh()
+	}
+}
+
+// Get the current state of the label, the state with the highest priority wins
+func (l *ColorLabel) GetState() StateType {
+	switch {
+	case l.disabled:
+		return StateDisabled
+	case l.pressed:
+		return StatePressed
+	case l.selected:
+		return StateSelected
+	case l.hover.hovered:
+		return StateHover
+	}
+	return StateNormal
+}
+
+// Returns the style to render for the current states
+func (l *ColorLabel) stateStyle() Style {
+	s := Style{
+		TextColor:       l.fgColor,
+		BackgroundColor: l.bgColor,
+		BorderColor:     l.borderColor,
+		BorderWidth:     l.borderWidth,
+	}
+	overlay := func(state StateType) {
+		o, ok := l.stateStyles[state]
+		if !ok {
+			return
+		}
+		if !isDefaultColor(o.TextColor) {
+			s.TextColor = o.TextColor
+		}
+		if !isDefaultColor(o.BackgroundColor) {
+			s.BackgroundColor = o.BackgroundColor
+		}
+		if !isDefaultColor(o.BorderColor) {
+			s.BorderColor = o.BorderColor
+		}
+		if o.BorderWidth > 0 {
+			s.BorderWidth = o.BorderWidth
+		}
+	}
+	if l.hover.hovered {
+		overlay(StateHover)
+	}
+	if l.selected {
+		if _, ok := l.stateStyles[StateSelected]; !ok {
+			s.BackgroundColor = theme.ColorNameSelection
+		}
+		overlay(StateSelected)
+	}
+	if l.pressed {
+		if o, ok := l.stateStyles[StatePressed]; !ok || isDefaultColor(o.BackgroundColor) {
+			s.BackgroundColor = colorutil.Over(getColor(s.BackgroundColor), theme.Color(theme.ColorNamePressed))
+		}
+		overlay(StatePressed)
+	}
+	if l.disabled {
+		if _, ok := l.stateStyles[StateDisabled]; !ok {
+			s.TextColor = theme.ColorNameDisabled
+		}
+		overlay(StateDisabled)
+	}
+	return s
+}
+
+// Returns the text and background color to render for the current states
+func (l *ColorLabel) stateColors() (any, any) {
+	s := l.stateStyle()
+	if l.showsPlaceholder() && !l.disabled {
+		return l.placeholderColor(), s.BackgroundColor
+	}
+	return s.TextColor, s.BackgroundColor
+}
+
+// Disables the label, taps are ignored and the disabled style is used
+func (l *ColorLabel) Disable() {
+	if !l.disabled {
+		l.disabled = true
+		l.pressed = false
+		l.Refresh()
+	}
+}
+
+// Enables the label again
+func (l *ColorLabel) Enable() {
+	if l.disabled {
+		l.disabled = false
+		l.Refresh()
+	}
+}
+
+// Disableable interface
+func (l *ColorLabel) Disabled() bool {
+	return l.disabled
+}
+
+// Set the selected state
+// OnSelectionChanged is called if the state changes
+// A label in a ColorLabelGroup is selected through the group, other labels are deselected
+func (l *ColorLabel) SetSelected(selected bool) {
+	if l.group != nil {
+		l.group.setLabelSelected(l, selected)
+		return
+	}
+	l.setSelected(selected)
+}
+
+func (l *ColorLabel) setSelected(selected bool) {
+	if l.selected != selected {
+		l.selected = selected
+		l.Refresh()
+		if l.OnSelectionChanged != nil {
+			l.OnSelectionChanged(selected)
+		}
+	}
+}
+
+// If selectable is true a tap toggles the selected state
+func (l *ColorLabel) SetSelectable(selectable bool) {
+	l.selectable = selectable
+}
+
+// Set the background color of the selected state
+// c is NRGBA or fyne.ThemeColorName, nil uses the theme selection color
+// Shortcut for SetStateStyle with StateSelected
+func (l *ColorLabel) SetSelectionColor(c any) error {
+	if c == nil {
+		c = theme.ColorNameSelection
+	}
+	return l.SetStateStyle(StateSelected, Style{BackgroundColor: c})
+}
+
+// Reports if the label is selected
+func (l *ColorLabel) IsSelected() bool {
+	return l.selected
+}
@@ -0,0 +1,236 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// StatusLabel, a small colored dot followed by a text, e.g. for
+// presence (online, away, offline) or connection states.
+
+package colorlabel
+
+import (
+	"image/color"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+type StatusType int
+
+const (
+	StatusOffline StatusType = iota
+	StatusOnline
+	StatusAway
+	StatusBusy
+	StatusConnecting
+	StatusCustom
+)
+
+// dot size relative to the text size
+const statusDotScale = 0.6
+
+var (
+	statusNames = []string{"offline", "online", "away", "busy", "connecting", "custom"}
+
+	_ fyne.Widget = (*StatusLabel)(nil)
+)
+
+// Returns the name of the status, e.g. "online"
+func (s StatusType) String() string {
+	if s < StatusOffline || s > StatusCustom {
+		return "unknown"
+	}
+	return statusNames[s]
+}
+
+// Returns the dot color of a status
+func statusColor(s StatusType) any {
+	switch s {
+	case StatusOnline:
+		return theme.ColorNameSuccess
+	case StatusAway:
+		return theme.ColorNameWarning
+	case StatusBusy:
+		return theme.ColorNameError
+	case StatusConnecting:
+		return theme.ColorNamePrimary
+	}
+	return theme.ColorNameDisabled
+}
+
+// Colored status dot followed by a text
+// The status name is the color meaning of the text for assistive technologies.
+// Implements
+//   - fyne.Widget
+type StatusLabel struct {
+	widget.BaseWidget
+
+	status   StatusType
+	dotColor any
+	blink    bool
+	label    *ColorLabel
+}
+
+// Creates a new StatusLabel
+// StatusConnecting blinks
+func NewStatusLabel(status StatusType, text string) *StatusLabel {
+	l := &StatusLabel{
+		label: NewColorLabel(text, nil, nil, 1),
+	}
+	l.setStatus(status, statusColor(status), status == StatusConnecting)
+	l.ExtendBaseWidget(l)
+	return l
+}
+
+// Set the status, the dot color is taken from the status
+// StatusConnecting blinks
+func (l *StatusLabel) SetStatus(status StatusType) {
+	l.setStatus(status, statusColor(status), status == StatusConnecting)
+	l.Refresh()
+}
+
+// Get the status
+func (l *StatusLabel) GetStatus() StatusType {
+	return l.status
+}
+
+// Set a custom status with dot color c, the status is StatusCustom
+// meaning is used as color meaning for assistive technologies
+// c is NRGBA or fyne.ThemeColorName
+func (l *StatusLabel) SetCustomStatus(c any, meaning string, blink bool) error {
+	if err := ValidateColor(c); err != nil {
+		return err
+	}
+	l.setStatus(StatusCustom, c, blink)
+	l.label.SetColorMeaning(meaning)
+	l.Refresh()
+	return nil
+}
+
+// Switch blinking of the dot on or off
+func (l *StatusLabel) SetBlinking(blink bool) {
+	l.blink = blink
+	l.Refresh()
+}
+
+// Set new text
+func (l *StatusLabel) SetText(s string) {
+	l.label.SetText(s)
+}
+
+// Get the text
+func (l *StatusLabel) GetText() string {
+	return l.label.GetText()
+}
+
+// Get the label showing the text
+func (l *StatusLabel) GetLabel() *ColorLabel {
+	return l.label
+}
+
+func (l *StatusLabel) setStatus(status StatusType, c any, blink bool) {
+	l.status = status
+	l.dotColor = c
+	l.blink = blink
+	l.label.SetColorMeaning(status.String())
+}
+
+// Widget interface
+func (l *StatusLabel) CreateRenderer() fyne.WidgetRenderer {
+	r := &statusLabelRenderer{
+		l:   l,
+		dot: canvas.NewCircle(color.Transparent),
+	}
+	r.Refresh()
+	return r
+}
+
+type statusLabelRenderer struct {
+	l    *StatusLabel
+	dot  *canvas.Circle
+	anim *fyne.Animation
+}
+
+func (r *statusLabelRenderer) dotSize() float32 {
+	return theme.TextSize() * r.l.label.textScale * statusDotScale
+}
+
+// WidgetRenderer interface
+func (r *statusLabelRenderer) Layout(size fyne.Size) {
+	d := r.dotSize()
+	p := theme.Padding()
+	r.dot.Resize(fyne.NewSquareSize(d))
+	r.dot.Move(fyne.NewPos(p, (size.Height-d)/2))
+	r.l.label.Move(fyne.NewPos(p+d, 0))
+	r.l.label.Resize(fyne.NewSize(size.Width-p-d, size.Height))
+}
+
+// WidgetRenderer interface
+func (r *statusLabelRenderer) MinSize() fyne.Size {
+	ls := r.l.label.MinSize()
+	d := r.dotSize()
+	return fyne.NewSize(theme.Padding()+d+ls.Width, max(d, ls.Height))
+}
+
+// WidgetRenderer interface
+func (r *statusLabelRenderer) Refresh() {
+	r.dot.FillColor = getColor(r.l.dotColor)
+	r.dot.Hidden = false
+	r.dot.Refresh()
+	if r.l.blink && r.l.Visible() {
+		r.startBlink()
+	} else {
+		r.stopBlink()
+	}
+	r.l.label.Refresh()
+}
+
+// WidgetRenderer interface
+func (r *statusLabelRenderer) Destroy() {
+	r.stopBlink()
+}
+
+// WidgetRenderer interface
+func (r *statusLabelRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.dot, r.l.label}
+}
+
+func (r *statusLabelRenderer) startBlink() {
+	if r.anim != nil {
+		return
+	}
+	r.anim = fyne.NewAnimation(time.Second, func(f float32) {
+		r.dot.Hidden = f >= 0.5
+		r.dot.Refresh()
+	})
+	r.anim.Curve = fyne.AnimationLinear
+	r.anim.RepeatCount = fyne.AnimationRepeatForever
+	r.anim.Start()
+}
+
+func (r *statusLabelRenderer) stopBlink() {
+	if r.anim != nil {
+		r.anim.Stop()
+		r.anim = nil
+	}
+}
@@ -0,0 +1,263 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// StatusBar arranges named ColorLabel fields in left, center and right
+// zones and truncates the least important fields first.
+
+package colorlabel
+
+import (
+	"slices"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+var _ fyne.Widget = (*StatusBar)(nil)
+
+type StatusZoneType int
+
+const (
+	ZoneLeft StatusZoneType = iota
+	ZoneCenter
+	ZoneRight
+)
+
+type statusField struct {
+	name     string
+	label    *ColorLabel
+	zone     StatusZoneType
+	priority int
+}
+
+// Status bar made of named ColorLabel fields
+// If the bar is too narrow, fields with lower priority are truncated
+// and then hidden first.
+// Implements
+//   - fyne.Widget
+type StatusBar struct {
+	widget.BaseWidget
+
+	fields  []*statusField
+	bgColor any
+}
+
+// Creates a new empty StatusBar
+func NewStatusBar() *StatusBar {
+	s := &StatusBar{
+		bgColor: theme.ColorNameHeaderBackground,
+	}
+	s.ExtendBaseWidget(s)
+	return s
+}
+
+// Widget interface
+func (s *StatusBar) CreateRenderer() fyne.WidgetRenderer {
+	r := &statusBarRenderer{
+		s:  s,
+		bg: canvas.NewRectangle(getColor(s.bgColor)),
+	}
+	r.Refresh()
+	return r
+}
+
+// Adds a new field to a zone and returns its label
+// Fields with higher priority are truncated last.
+// An existing field with the same name is moved to the zone.
+func (s *StatusBar) AddField(name string, zone StatusZoneType, priority int) *ColorLabel {
+	if f := s.field(name); f != nil {
+		f.zone = zone
+		f.priority = priority
+		s.Refresh()
+		return f.label
+	}
+	l := NewColorLabel("", nil, nil, 1)
+	l.SetTruncateMode(End)
+	l.SetSizeToContent(true)
+	s.fields = append(s.fields, &statusField{
+		name:     name,
+		label:    l,
+		zone:     zone,
+		priority: priority,
+	})
+	s.Refresh()
+	return l
+}
+
+// Set text and optional style of a field
+// Unknown fields are added to the left zone with priority 0.
+func (s *StatusBar) SetField(name, text string, style *Style) error {
+	f := s.field(name)
+	if f == nil {
+		s.AddField(name, ZoneLeft, 0)
+		f = s.field(name)
+	}
+	if style != nil {
+		st := *style
+		if st.Truncate == None {
+			st.Truncate = End
+		}
+		if err := f.label.ApplyStyle(st); err != nil {
+			return err
+		}
+	}
+	f.label.SetText(text)
+	s.Refresh()
+	return nil
+}
+
+// Get the label of a field, nil if there is no such field
+func (s *StatusBar) GetField(name string) *ColorLabel {
+	if f := s.field(name); f != nil {
+		return f.label
+	}
+	return nil
+}
+
+// Removes a field
+func (s *StatusBar) RemoveField(name string) {
+	s.fields = slices.DeleteFunc(s.fields, func(f *statusField) bool {
+		return f.name == name
+	})
+	s.Refresh()
+}
+
+// Set new background color
+// backColor is NRGBA or fyne.ThemeColorName
+func (s *StatusBar) SetBackgroundColor(backColor any) error {
+	backColor, err := normalizeBackgroundColor(backColor)
+	if err != nil {
+		return err
+	}
+	s.bgColor = backColor
+	s.Refresh()
+	return nil
+}
+
+func (s *StatusBar) field(name string) *statusField {
+	for _, f := range s.fields {
+		if f.name == name {
+			return f
+		}
+	}
+	return nil
+}
+
+type statusBarRenderer struct {
+	s    *StatusBar
+	bg   *canvas.Rectangle
+	objs []fyne.CanvasObject
+}
+
+// WidgetRenderer interface
+func (r *statusBarRenderer) Layout(size fyne.Size) {
+	r.bg.Resize(size)
+	fields := r.s.fields
+	pad := theme.Padding()
+	widths := make([]float32, len(fields))
+	var total float32
+	for i, f := range fields {
+		widths[i] = f.label.MinSize().Width
+		total += widths[i] + pad
+	}
+
+	// shrink and then hide fields with the lowest priority first
+	order := make([]int, len(fields))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		return fields[a].priority - fields[b].priority
+	})
+	minWidth := fyne.MeasureText(defaultEllipsis, theme.TextSize(), fyne.TextStyle{}).Width + 2*theme.InnerPadding()
+	for _, i := range order {
+		if total <= size.Width {
+			break
+		}
+		shrink := min(total-size.Width, widths[i]-minWidth)
+		if shrink > 0 {
+			widths[i] -= shrink
+			total -= shrink
+		}
+	}
+	for _, i := range order {
+		if total <= size.Width {
+			break
+		}
+		total -= widths[i] + pad
+		widths[i] = 0
+	}
+
+	var zoneWidth [3]float32
+	for i, f := range fields {
+		if widths[i] > 0 {
+			zoneWidth[f.zone] += widths[i] + pad
+		}
+	}
+	var x [3]float32
+	x[ZoneLeft] = 0
+	x[ZoneRight] = size.Width - zoneWidth[ZoneRight] + pad
+	x[ZoneCenter] = (size.Width - zoneWidth[ZoneCenter] + pad) / 2
+	x[ZoneCenter] = max(x[ZoneCenter], zoneWidth[ZoneLeft])
+	x[ZoneCenter] = min(x[ZoneCenter], x[ZoneRight]-zoneWidth[ZoneCenter])
+	for i, f := range fields {
+		if widths[i] <= 0 {
+			f.label.Hide()
+			continue
+		}
+		f.label.Resize(fyne.NewSize(widths[i], size.Height))
+		f.label.Move(fyne.NewPos(x[f.zone], 0))
+		f.label.Show()
+		x[f.zone] += widths[i] + pad
+	}
+}
+
+// WidgetRenderer interface
+func (r *statusBarRenderer) MinSize() fyne.Size {
+	var h float32
+	for _, f := range r.s.fields {
+		h = max(h, f.label.MinSize().Height)
+	}
+	return fyne.NewSize(0, h)
+}
+
+// WidgetRenderer interface
+func (r *statusBarRenderer) Refresh() {
+	r.bg.FillColor = getColor(r.s.bgColor)
+	r.bg.Refresh()
+	r.objs = []fyne.CanvasObject{r.bg}
+	for _, f := range r.s.fields {
+		r.objs = append(r.objs, f.label)
+	}
+	r.Layout(r.s.Size())
+}
+
+// WidgetRenderer interface
+func (r *statusBarRenderer) Destroy() {
+}
+
+// WidgetRenderer interface
+func (r *statusBarRenderer) Objects() []fyne.CanvasObject {
+	return r.objs
+}
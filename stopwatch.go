@@ -0,0 +1,162 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// StopwatchLabel shows the elapsed time, all running stopwatches share
+// a single ticker.
+
+package colorlabel
+
+import (
+	"fmt"
+	"time"
+
+	"fyne.io/fyne/v2"
+)
+
+const stopwatchInterval = 100 * time.Millisecond
+
+var stopwatchTicker = newSharedTicker(stopwatchInterval)
+
+// Label showing the elapsed time of a stopwatch
+// The label is only updated while it is shown.
+type StopwatchLabel struct {
+	ColorLabel
+
+	elapsed  time.Duration
+	started  time.Time
+	running  bool
+	rendered bool
+
+	// Formats the elapsed time, nil uses FormatElapsed
+	Format func(elapsed time.Duration) string
+}
+
+// Creates a new stopped StopwatchLabel showing 0:00
+func NewStopwatchLabel() *StopwatchLabel {
+	l := &StopwatchLabel{}
+	l.initEmbedded()
+	l.ExtendBaseWidget(l)
+	l.fullText = l.format(0)
+	return l
+}
+
+// Widget interface
+func (l *StopwatchLabel) CreateRenderer() fyne.WidgetRenderer {
+	l.rendered = true
+	// no refresh while the renderer is created
+	l.fullText = l.format(l.Elapsed())
+	l.subscribe()
+	return &lifecycleRenderer{WidgetRenderer: l.ColorLabel.CreateRenderer(), destroy: func() {
+		l.rendered = false
+		stopwatchTicker.unsubscribe(l)
+	}}
+}
+
+// Starts or continues the stopwatch
+func (l *StopwatchLabel) Start() {
+	if l.running {
+		return
+	}
+	l.started = time.Now()
+	l.running = true
+	l.subscribe()
+}
+
+// Pauses the stopwatch, Start continues it
+func (l *StopwatchLabel) Pause() {
+	if !l.running {
+		return
+	}
+	l.elapsed = l.Elapsed()
+	l.running = false
+	stopwatchTicker.unsubscribe(l)
+	l.update()
+}
+
+// Sets the elapsed time to 0, a running stopwatch keeps running
+func (l *StopwatchLabel) Reset() {
+	l.elapsed = 0
+	l.started = time.Now()
+	l.update()
+}
+
+// Get the elapsed time
+func (l *StopwatchLabel) Elapsed() time.Duration {
+	if !l.running {
+		return l.elapsed
+	}
+	return l.elapsed + time.Since(l.started)
+}
+
+// Reports if the stopwatch is running
+func (l *StopwatchLabel) IsRunning() bool {
+	return l.running
+}
+
+// Hides the label and stops updating it
+func (l *StopwatchLabel) Hide() {
+	stopwatchTicker.unsubscribe(l)
+	l.ColorLabel.Hide()
+}
+
+// Shows the label and updates it again
+func (l *StopwatchLabel) Show() {
+	l.ColorLabel.Show()
+	l.update()
+	l.subscribe()
+}
+
+// Formats a duration as "m:ss" or "h:mm:ss"
+func FormatElapsed(d time.Duration) string {
+	s := int(d / time.Second)
+	if s >= 3600 {
+		return fmt.Sprintf("%d:%02d:%02d", s/3600, s/60%60, s%60)
+	}
+	return fmt.Sprintf("%d:%02d", s/60, s%60)
+}
+
+// Formats a duration as "m:ss.t" or "h:mm:ss.t" with tenths of a second
+func FormatElapsedTenths(d time.Duration) string {
+	return fmt.Sprintf("%s.%d", FormatElapsed(d), d/(100*time.Millisecond)%10)
+}
+
+func (l *StopwatchLabel) format(d time.Duration) string {
+	if l.Format != nil {
+		return l.Format(d)
+	}
+	return FormatElapsed(d)
+}
+
+func (l *StopwatchLabel) subscribe() {
+	if l.running && l.rendered && l.Visible() {
+		stopwatchTicker.subscribe(l, l.update)
+	}
+}
+
+// Refreshes the label if the formatted time changed
+func (l *StopwatchLabel) update() {
+	s := l.format(l.Elapsed())
+	if s != l.fullText {
+		l.fullText = s
+		l.Refresh()
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Style describes the appearance of a ColorLabel, so many labels can
+// share one style definition and be restyled together.
+
+package colorlabel
+
+import (
+	"fyne.io/fyne/v2"
+)
+
+// Appearance of a ColorLabel
+// TextColor and BackgroundColor are NRGBA or fyne.ThemeColorName, nil uses the default colors
+// TextScale <= 0 means 1, TextStyle nil means normal text
+// BorderColor is NRGBA or fyne.ThemeColorName, the border is drawn if BorderWidth > 0
+// Padding nil means the theme padding
+type Style struct {
+	TextColor       any
+	BackgroundColor any
+	TextScale       float32
+	TextStyle       *fyne.TextStyle
+	BorderColor     any
+	BorderWidth     float32
+	Truncate        TruncateModeType
+	Padding         *Padding
+}
+
+// Creates a new ColorLabel with the given style
+// Returns nil if a color of the style is not supported
+func NewColorLabelWithStyle(s string, style Style) *ColorLabel {
+	l := NewColorLabel(s, nil, nil, 1)
+	if l.ApplyStyle(style) != nil {
+		return nil
+	}
+	return l
+}
+
+// Applies the style to all labels
+func ApplyStyleTo(style Style, labels ...*ColorLabel) error {
+	for _, l := range labels {
+		if err := l.ApplyStyle(style); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Checks the colors of a style
+func validateStyle(style Style) error {
+	for _, c := range []any{style.TextColor, style.BackgroundColor, style.BorderColor} {
+		if err := ValidateColor(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Returns the current style of the label
+func (l *ColorLabel) GetStyle() Style {
+	style := *l.textStyle
+	s := Style{
+		TextColor:       l.fgColor,
+		BackgroundColor: l.bgColor,
+		TextScale:       l.textScale,
+		TextStyle:       &style,
+		BorderColor:     l.borderColor,
+		BorderWidth:     l.borderWidth,
+		Truncate:        l.truncate,
+	}
+	if l.padding != nil {
+		p := *l.padding
+		s.Padding = &p
+	}
+	return s
+}
+
+// Set all properties of the style with only one refresh
+func (l *ColorLabel) ApplyStyle(s Style) error {
+	fg, err := normalizeTextColor(s.TextColor)
+	if err != nil {
+		return err
+	}
+	if err := ValidateColor(s.BorderColor); err != nil {
+		return err
+	}
+	bg, err := normalizeBackgroundColor(s.BackgroundColor)
+	if err != nil {
+		return err
+	}
+	l.fgColor = fg
+	l.bgColor = bg
+	l.borderColor = s.BorderColor
+	l.borderWidth = s.BorderWidth
+	l.truncate = s.Truncate
+	l.padding = nil
+	if s.Padding != nil {
+		p := *s.Padding
+		l.padding = &p
+	}
+	l.textScale = s.TextScale
+	if l.textScale <= 0 {
+		l.textScale = 1
+	}
+	if s.TextStyle != nil {
+		style := *s.TextStyle
+		l.textStyle = &style
+	} else {
+		l.textStyle = &fyne.TextStyle{}
+	}
+	l.Refresh()
+	return nil
+}
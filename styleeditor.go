@@ -0,0 +1,122 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Style editor dialog, lets end users change text and background color,
+// text scale and text style of a label interactively.
+
+package colorlabel
+
+import (
+	"fmt"
+	"image/color"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// Shows a dialog for editing the style of the label in window w
+// On "Apply" the style is applied to the label. callback gets the edited
+// style and false if the dialog was cancelled, it may be nil.
+func ShowStyleEditor(l *ColorLabel, w fyne.Window, callback func(style Style, ok bool)) {
+	st := l.GetStyle()
+	textStyle := *st.TextStyle
+	st.TextStyle = &textStyle
+
+	preview := NewColorLabelWithStyle(l.GetText(), st)
+	if preview == nil {
+		preview = NewColorLabel(l.GetText(), nil, nil, 1)
+	}
+	preview.SetTruncateMode(End)
+	update := func() {
+		preview.ApplyStyle(st)
+	}
+
+	fg := colorSwatch(st.TextColor, func(c color.Color) {
+		st.TextColor = color.NRGBAModel.Convert(c)
+		update()
+	})
+	bg := colorSwatch(st.BackgroundColor, func(c color.Color) {
+		st.BackgroundColor = color.NRGBAModel.Convert(c)
+		update()
+	})
+
+	scaleText := widget.NewLabel(fmt.Sprintf("%.1f", st.TextScale))
+	scale := widget.NewSlider(0.5, 3)
+	scale.Step = 0.1
+	scale.Value = float64(st.TextScale)
+	scale.OnChanged = func(v float64) {
+		st.TextScale = float32(v)
+		scaleText.SetText(fmt.Sprintf("%.1f", v))
+		update()
+	}
+
+	check := func(name string, b *bool) *widget.Check {
+		c := widget.NewCheck(name, func(on bool) {
+			*b = on
+			update()
+		})
+		c.Checked = *b
+		return c
+	}
+	styles := container.NewHBox(
+		check("Bold", &textStyle.Bold),
+		check("Italic", &textStyle.Italic),
+		check("Monospace", &textStyle.Monospace),
+	)
+
+	form := widget.NewForm(
+		widget.NewFormItem("Text color", fg),
+		widget.NewFormItem("Background", bg),
+		widget.NewFormItem("Scale", container.NewBorder(nil, nil, nil, scaleText, scale)),
+		widget.NewFormItem("Style", styles),
+	)
+	content := container.NewVBox(preview, widget.NewSeparator(), form)
+	d := dialog.NewCustomConfirm("Edit style", "Apply", "Cancel", content, func(ok bool) {
+		if ok {
+			l.ApplyStyle(st)
+		}
+		if callback != nil {
+			callback(st, ok)
+		}
+	}, w)
+	d.Show()
+}
+
+// Label showing a color, a tap opens a color picker
+func colorSwatch(c any, changed func(color.Color)) *ColorLabel {
+	s := NewColorLabelWithStyle("        ", Style{
+		BackgroundColor: c,
+		BorderColor:     theme.ColorNameForeground,
+		BorderWidth:     1,
+	})
+	if s == nil {
+		s = NewColorLabel("        ", nil, nil, 1)
+	}
+	s.EnableColorPicking(ColorTargetBackground)
+	s.OnColorChanged = func(_ ColorTargetType, c color.Color) {
+		changed(c)
+	}
+	return s
+}
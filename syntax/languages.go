@@ -0,0 +1,89 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Built-in language descriptions.
+
+package syntax
+
+func init() {
+	Register(&Language{
+		Name: "go",
+		Keywords: []string{"break", "case", "chan", "const", "continue", "default", "defer", "else",
+			"fallthrough", "for", "func", "go", "goto", "if", "import", "interface", "map", "package",
+			"range", "return", "select", "struct", "switch", "type", "var", "true", "false", "nil", "iota"},
+		Types: []string{"any", "bool", "byte", "complex64", "complex128", "error", "float32", "float64",
+			"int", "int8", "int16", "int32", "int64", "rune", "string", "uint", "uint8", "uint16",
+			"uint32", "uint64", "uintptr"},
+		LineComments:  []string{"//"},
+		BlockComments: [][2]string{{"/*", "*/"}},
+		Quotes:        `"'`,
+		RawQuotes:     "`",
+	})
+	Register(&Language{
+		Name: "python",
+		Keywords: []string{"and", "as", "assert", "async", "await", "break", "class", "continue", "def",
+			"del", "elif", "else", "except", "finally", "for", "from", "global", "if", "import", "in",
+			"is", "lambda", "nonlocal", "not", "or", "pass", "raise", "return", "try", "while", "with",
+			"yield", "True", "False", "None"},
+		Types:        []string{"bool", "bytes", "dict", "float", "int", "list", "object", "set", "str", "tuple"},
+		LineComments: []string{"#"},
+		Quotes:       `"'`,
+	})
+	Register(&Language{
+		Name: "javascript",
+		Keywords: []string{"async", "await", "break", "case", "catch", "class", "const", "continue",
+			"default", "delete", "do", "else", "export", "extends", "finally", "for", "function", "if",
+			"import", "in", "instanceof", "let", "new", "of", "return", "static", "super", "switch",
+			"this", "throw", "try", "typeof", "var", "void", "while", "yield", "true", "false", "null",
+			"undefined"},
+		Types:         []string{"Array", "Boolean", "Map", "Number", "Object", "Promise", "Set", "String"},
+		LineComments:  []string{"//"},
+		BlockComments: [][2]string{{"/*", "*/"}},
+		Quotes:        "\"'`",
+	})
+	Register(&Language{
+		Name:     "json",
+		Keywords: []string{"true", "false", "null"},
+		Quotes:   `"`,
+	})
+	Register(&Language{
+		Name: "shell",
+		Keywords: []string{"case", "do", "done", "elif", "else", "esac", "export", "fi", "for",
+			"function", "if", "in", "local", "return", "then", "until", "while"},
+		LineComments: []string{"#"},
+		Quotes:       `"`,
+		RawQuotes:    "'",
+	})
+	Register(&Language{
+		Name: "sql",
+		Keywords: []string{"add", "all", "alter", "and", "as", "asc", "by", "case", "create", "delete",
+			"desc", "distinct", "drop", "else", "end", "exists", "from", "group", "having", "in",
+			"index", "inner", "insert", "into", "is", "join", "left", "like", "limit", "not", "null",
+			"on", "or", "order", "outer", "primary", "key", "right", "select", "set", "table", "then",
+			"union", "update", "values", "when", "where"},
+		Types:         []string{"bigint", "boolean", "char", "date", "decimal", "float", "int", "integer", "text", "timestamp", "varchar"},
+		LineComments:  []string{"--"},
+		BlockComments: [][2]string{{"/*", "*/"}},
+		Quotes:        `'"`,
+		IgnoreCase:    true,
+	})
+}
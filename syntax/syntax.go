@@ -0,0 +1,256 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Package syntax tokenizes short code snippets and turns the tokens into
+// colored segments for a monospace ColorLabel.
+
+package syntax
+
+import (
+	"slices"
+	"strings"
+	"sync"
+	"unicode"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+
+	"github.com/bytemystery-com/colorlabel"
+)
+
+type TokenType int
+
+const (
+	Text TokenType = iota
+	Keyword
+	Type
+	Function
+	String
+	Number
+	Comment
+	Operator
+)
+
+// Part of the code with its token type
+type Token struct {
+	Type TokenType
+	Text string
+}
+
+// Description of a language for the tokenizer
+type Language struct {
+	Name          string
+	Keywords      []string
+	Types         []string
+	LineComments  []string
+	BlockComments [][2]string
+	Quotes        string
+	// Quotes without escapes, e.g. the back quote in Go
+	RawQuotes  string
+	IgnoreCase bool
+}
+
+// Colors per token type, NRGBA or fyne.ThemeColorName
+// Missing types use the label text color.
+type Palette map[TokenType]any
+
+// Palette built from theme colors, so it fits light and dark themes
+var DefaultPalette = Palette{
+	Keyword: theme.ColorNamePrimary,
+	Type:    theme.ColorNameWarning,
+	String:  theme.ColorNameSuccess,
+	Number:  theme.ColorNameError,
+	Comment: theme.ColorNameDisabled,
+}
+
+var (
+	languagesLock sync.RWMutex
+	languages     = make(map[string]*Language)
+)
+
+// Registers a language, the name is case insensitive
+func Register(lang *Language) {
+	languagesLock.Lock()
+	defer languagesLock.Unlock()
+	languages[strings.ToLower(lang.Name)] = lang
+}
+
+// Get a registered language
+func Lookup(name string) (*Language, bool) {
+	languagesLock.RLock()
+	defer languagesLock.RUnlock()
+	l, ok := languages[strings.ToLower(name)]
+	return l, ok
+}
+
+// Splits code into tokens
+// Without a language the whole code is one Text token.
+func Tokenize(code string, lang *Language) []Token {
+	if lang == nil {
+		if code == "" {
+			return nil
+		}
+		return []Token{{Type: Text, Text: code}}
+	}
+	var tokens []Token
+	r := []rune(code)
+	add := func(t TokenType, from, to int) {
+		// merge with the previous token of the same type
+		if n := len(tokens); n > 0 && tokens[n-1].Type == t {
+			tokens[n-1].Text += string(r[from:to])
+			return
+		}
+		tokens = append(tokens, Token{Type: t, Text: string(r[from:to])})
+	}
+	hasPrefix := func(i int, p string) bool {
+		return p != "" && strings.HasPrefix(string(r[i:min(i+len(p), len(r))]), p)
+	}
+	for i := 0; i < len(r); {
+		c := r[i]
+		start := i
+		switch {
+		case unicode.IsSpace(c):
+			for i < len(r) && unicode.IsSpace(r[i]) {
+				i++
+			}
+			add(Text, start, i)
+			continue
+		case lineComment(lang, i, hasPrefix):
+			for i < len(r) && r[i] != '\n' {
+				i++
+			}
+			add(Comment, start, i)
+			continue
+		}
+		if end, ok := blockComment(lang, r, i, hasPrefix); ok {
+			add(Comment, start, end)
+			i = end
+			continue
+		}
+		switch {
+		case strings.ContainsRune(lang.Quotes, c) || strings.ContainsRune(lang.RawQuotes, c):
+			raw := strings.ContainsRune(lang.RawQuotes, c)
+			i++
+			for i < len(r) && r[i] != c {
+				if r[i] == '\\' && !raw {
+					i++
+				}
+				i++
+			}
+			i = min(i+1, len(r))
+			add(String, start, i)
+		case unicode.IsDigit(c):
+			for i < len(r) && (unicode.IsDigit(r[i]) || unicode.IsLetter(r[i]) || r[i] == '.' || r[i] == '_') {
+				i++
+			}
+			add(Number, start, i)
+		case unicode.IsLetter(c) || c == '_':
+			for i < len(r) && (unicode.IsLetter(r[i]) || unicode.IsDigit(r[i]) || r[i] == '_') {
+				i++
+			}
+			word := string(r[start:i])
+			switch {
+			case lang.contains(lang.Keywords, word):
+				add(Keyword, start, i)
+			case lang.contains(lang.Types, word):
+				add(Type, start, i)
+			case i < len(r) && r[i] == '(':
+				add(Function, start, i)
+			default:
+				add(Text, start, i)
+			}
+		default:
+			i++
+			if unicode.IsPunct(c) || unicode.IsSymbol(c) {
+				add(Operator, start, i)
+			} else {
+				add(Text, start, i)
+			}
+		}
+	}
+	return tokens
+}
+
+func (lang *Language) contains(words []string, w string) bool {
+	if lang.IgnoreCase {
+		return slices.ContainsFunc(words, func(s string) bool {
+			return strings.EqualFold(s, w)
+		})
+	}
+	return slices.Contains(words, w)
+}
+
+func lineComment(lang *Language, i int, hasPrefix func(int, string) bool) bool {
+	for _, p := range lang.LineComments {
+		if hasPrefix(i, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the end of a block comment starting at i
+func blockComment(lang *Language, r []rune, i int, hasPrefix func(int, string) bool) (int, bool) {
+	for _, b := range lang.BlockComments {
+		if !hasPrefix(i, b[0]) {
+			continue
+		}
+		rest := string(r[i+len([]rune(b[0])):])
+		end := strings.Index(rest, b[1])
+		if end < 0 {
+			return len(r), true
+		}
+		return i + len([]rune(b[0])) + len([]rune(rest[:end+len(b[1])])), true
+	}
+	return 0, false
+}
+
+// Converts tokens into colored segments, nil uses the DefaultPalette
+// Pre-tokenized spans can be passed directly.
+func Segments(tokens []Token, palette Palette) []colorlabel.Segment {
+	if palette == nil {
+		palette = DefaultPalette
+	}
+	segs := make([]colorlabel.Segment, 0, len(tokens))
+	for _, t := range tokens {
+		segs = append(segs, colorlabel.Segment{Text: t.Text, TextColor: palette[t.Type]})
+	}
+	return segs
+}
+
+// Returns the colored segments of code in the given language
+// Unknown languages are returned as plain text.
+func Highlight(code, language string) []colorlabel.Segment {
+	lang, ok := Lookup(language)
+	if !ok {
+		return []colorlabel.Segment{{Text: code}}
+	}
+	return Segments(Tokenize(code, lang), nil)
+}
+
+// Creates a new monospace ColorLabel showing highlighted code
+func NewCodeLabel(code, language string) *colorlabel.ColorLabel {
+	l := colorlabel.NewColorLabel("", nil, nil, 1)
+	l.SetTextStyle(&fyne.TextStyle{Monospace: true})
+	l.SetSegments(Highlight(code, language))
+	return l
+}
@@ -0,0 +1,136 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// TableCellFactory provides the callbacks of a widget.Table showing
+// ColorLabel cells with per cell styles, row striping and column alignment.
+
+package colorlabel
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/widget"
+)
+
+// Factory for ColorLabel cells of a widget.Table
+// The cells are truncated at the end and recycled by the table.
+type TableCellFactory struct {
+	cell       func(id widget.TableCellID) string
+	alignments map[int]fyne.TextAlign
+	stripe     any
+	table      *widget.Table
+
+	// Returns the style of a cell, the default colors are used if nil
+	Style func(id widget.TableCellID, text string) Style
+	// Called when a cell is tapped
+	OnSelected func(id widget.TableCellID)
+}
+
+// Creates a new TableCellFactory, cell returns the text of a cell
+func NewTableCellFactory(cell func(id widget.TableCellID) string) *TableCellFactory {
+	return &TableCellFactory{
+		cell:       cell,
+		alignments: make(map[int]fyne.TextAlign),
+	}
+}
+
+// Creates a new widget.Table using the factory
+// length returns the number of rows and columns
+func (f *TableCellFactory) NewTable(length func() (rows int, cols int)) *widget.Table {
+	t := widget.NewTable(length, f.CreateCell, f.UpdateCell)
+	f.Attach(t)
+	return t
+}
+
+// Connects the factory to a table created with its callbacks, so a tap
+// on a cell selects it
+func (f *TableCellFactory) Attach(t *widget.Table) {
+	f.table = t
+	t.OnSelected = func(id widget.TableCellID) {
+		if f.OnSelected != nil {
+			f.OnSelected(id)
+		}
+	}
+}
+
+// Set the text alignment of a column
+func (f *TableCellFactory) SetColumnAlignment(col int, align fyne.TextAlign) {
+	f.alignments[col] = align
+	f.refresh()
+}
+
+// Get the text alignment of a column
+func (f *TableCellFactory) GetColumnAlignment(col int) fyne.TextAlign {
+	return f.alignments[col]
+}
+
+// Set the background of every second row for cells without own background
+// c is NRGBA or fyne.ThemeColorName, nil disables the striping
+func (f *TableCellFactory) SetStriping(c any) error {
+	if err := ValidateColor(c); err != nil {
+		return err
+	}
+	f.stripe = c
+	f.refresh()
+	return nil
+}
+
+// Get the color of the row striping
+func (f *TableCellFactory) GetStriping() any {
+	return f.stripe
+}
+
+// CreateCell callback of widget.Table
+func (f *TableCellFactory) CreateCell() fyne.CanvasObject {
+	l := NewColorLabel("", nil, nil, 1)
+	l.SetTruncateMode(End)
+	return l
+}
+
+// UpdateCell callback of widget.Table
+func (f *TableCellFactory) UpdateCell(id widget.TableCellID, o fyne.CanvasObject) {
+	l := o.(*ColorLabel)
+	text := f.cell(id)
+	var st Style
+	if f.Style != nil {
+		st = f.Style(id, text)
+	}
+	if st.Truncate == None {
+		st.Truncate = End
+	}
+	if f.stripe != nil && id.Row%2 == 1 && isDefaultColor(st.BackgroundColor) {
+		st.BackgroundColor = f.stripe
+	}
+	l.ApplyStyle(st)
+	l.SetAlinment(f.alignments[id.Col])
+	l.SetText(text)
+	l.OnTapped = func() {
+		if f.table != nil {
+			f.table.Select(id)
+		}
+	}
+}
+
+func (f *TableCellFactory) refresh() {
+	if f.table != nil {
+		f.table.Refresh()
+	}
+}
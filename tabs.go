@@ -0,0 +1,82 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Expansion of tab characters to tab stops in monospace mode,
+// so columnar text like key/value dumps lines up.
+
+package colorlabel
+
+import (
+	"strings"
+)
+
+const defaultTabWidth = 4
+
+// Set the distance of the tab stops in characters, values <= 0 mean 4
+// Tabs are expanded to the next tab stop in monospace mode only,
+// otherwise a tab is as wide as tab width spaces.
+func (l *ColorLabel) SetTabWidth(n int) {
+	if n <= 0 {
+		n = 0
+	}
+	if l.tabWidth != n {
+		l.tabWidth = n
+		l.Refresh()
+	}
+}
+
+// Get the distance of the tab stops in characters
+func (l *ColorLabel) GetTabWidth() int {
+	if l.tabWidth <= 0 {
+		return defaultTabWidth
+	}
+	return l.tabWidth
+}
+
+// Expands the tabs of s in monospace mode
+func (l *ColorLabel) expandTabs(s string) string {
+	if !l.textStyle.Monospace || !strings.Contains(s, "\t") {
+		return s
+	}
+	return expandTabs(s, l.GetTabWidth())
+}
+
+// Replaces each tab by spaces up to the next multiple of width
+func expandTabs(s string, width int) string {
+	var sb strings.Builder
+	col := 0
+	for _, r := range s {
+		switch r {
+		case '\t':
+			n := width - col%width
+			sb.WriteString(strings.Repeat(" ", n))
+			col += n
+		case '\n':
+			sb.WriteRune(r)
+			col = 0
+		default:
+			sb.WriteRune(r)
+			col++
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,139 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// TagCloud lays out ColorLabels in a wrapping flow, the text size of a
+// tag grows with its weight.
+
+package colorlabel
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/widget"
+)
+
+var _ fyne.Widget = (*TagCloud)(nil)
+
+// One tag of a TagCloud
+type CloudTag struct {
+	Text   string
+	Weight float64
+}
+
+// Cloud of weighted tags
+// Implements
+//   - fyne.Widget
+type TagCloud struct {
+	widget.BaseWidget
+
+	tags     []CloudTag
+	minScale float32
+	maxScale float32
+	box      *fyne.Container
+
+	// Returns text and background color of a tag, nil uses the default colors
+	// weight is normalized to 0 (lightest) - 1 (heaviest)
+	Palette func(tag CloudTag, weight float64) (txtColor, backColor any)
+	// Called when a tag is tapped
+	OnTagTapped func(tag CloudTag)
+}
+
+// Creates a new TagCloud, text scales range from 0.8 to 2
+func NewTagCloud(tags []CloudTag) *TagCloud {
+	c := &TagCloud{
+		tags:     tags,
+		minScale: 0.8,
+		maxScale: 2,
+		box:      container.New(newFlowLayout()),
+	}
+	c.ExtendBaseWidget(c)
+	c.rebuild()
+	return c
+}
+
+// Widget interface
+func (c *TagCloud) CreateRenderer() fyne.WidgetRenderer {
+	return widget.NewSimpleRenderer(c.box)
+}
+
+// Replaces the tags
+func (c *TagCloud) SetTags(tags []CloudTag) {
+	c.tags = tags
+	c.rebuild()
+}
+
+// Get the tags
+func (c *TagCloud) GetTags() []CloudTag {
+	return c.tags
+}
+
+// Set the text scales of the lightest and the heaviest tag
+func (c *TagCloud) SetScaleRange(minScale, maxScale float32) {
+	if minScale <= 0 || maxScale < minScale {
+		return
+	}
+	c.minScale = minScale
+	c.maxScale = maxScale
+	c.rebuild()
+}
+
+// Updates the labels, e.g. after changing the Palette
+func (c *TagCloud) Refresh() {
+	c.rebuild()
+	c.BaseWidget.Refresh()
+}
+
+func (c *TagCloud) rebuild() {
+	lo, hi := 0.0, 0.0
+	for i, t := range c.tags {
+		if i == 0 || t.Weight < lo {
+			lo = t.Weight
+		}
+		if i == 0 || t.Weight > hi {
+			hi = t.Weight
+		}
+	}
+	objs := make([]fyne.CanvasObject, 0, len(c.tags))
+	for _, t := range c.tags {
+		w := 1.0
+		if hi > lo {
+			w = (t.Weight - lo) / (hi - lo)
+		}
+		var fg, bg any
+		if c.Palette != nil {
+			fg, bg = c.Palette(t, w)
+		}
+		scale := c.minScale + (c.maxScale-c.minScale)*float32(w)
+		l := NewColorLabel(t.Text, fg, bg, scale)
+		if l == nil {
+			l = NewColorLabel(t.Text, nil, nil, scale)
+		}
+		l.OnTapped = func() {
+			if c.OnTagTapped != nil {
+				c.OnTagTapped(t)
+			}
+		}
+		objs = append(objs, l)
+	}
+	c.box.Objects = objs
+	c.box.Refresh()
+}
@@ -0,0 +1,161 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// TagInput combines an entry with a flow of ColorChips for editing a
+// list of tags.
+
+package colorlabel
+
+import (
+	"slices"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/widget"
+)
+
+var _ fyne.Widget = (*TagInput)(nil)
+
+// Tag editor, tags are added by pressing Enter in the entry and removed
+// with the delete mark of the chip. Duplicate tags are ignored.
+// Implements
+//   - fyne.Widget
+type TagInput struct {
+	widget.BaseWidget
+
+	tags  []string
+	chips *fyne.Container
+	entry *widget.Entry
+
+	// Returns text and background color for a tag, nil uses the chip defaults
+	Palette func(tag string) (txtColor, backColor any)
+	// Called whenever a tag is added or removed
+	OnChanged func(tags []string)
+}
+
+// Creates a new TagInput with initial tags
+func NewTagInput(tags ...string) *TagInput {
+	t := &TagInput{
+		chips: container.New(newFlowLayout()),
+		entry: widget.NewEntry(),
+	}
+	t.entry.OnSubmitted = func(s string) {
+		if t.AddTag(s) {
+			t.entry.SetText("")
+		}
+	}
+	t.ExtendBaseWidget(t)
+	for _, s := range tags {
+		t.addTag(s)
+	}
+	t.rebuild()
+	return t
+}
+
+// Widget interface
+func (t *TagInput) CreateRenderer() fyne.WidgetRenderer {
+	return widget.NewSimpleRenderer(container.NewVBox(t.chips, t.entry))
+}
+
+// Adds a tag, returns false if it is empty or already present
+func (t *TagInput) AddTag(s string) bool {
+	if !t.addTag(s) {
+		return false
+	}
+	t.rebuild()
+	t.changed()
+	return true
+}
+
+// Removes a tag, returns false if it is not present
+func (t *TagInput) RemoveTag(s string) bool {
+	i := slices.Index(t.tags, strings.TrimSpace(s))
+	if i < 0 {
+		return false
+	}
+	t.tags = slices.Delete(t.tags, i, i+1)
+	t.rebuild()
+	t.changed()
+	return true
+}
+
+// Replaces all tags
+func (t *TagInput) SetTags(tags []string) {
+	t.tags = nil
+	for _, s := range tags {
+		t.addTag(s)
+	}
+	t.rebuild()
+	t.changed()
+}
+
+// Get a copy of the tags
+func (t *TagInput) GetTags() []string {
+	return slices.Clone(t.tags)
+}
+
+// Set the placeholder of the entry
+func (t *TagInput) SetPlaceHolder(s string) {
+	t.entry.SetPlaceHolder(s)
+}
+
+// Rebuilds the chips, e.g. after changing the Palette
+func (t *TagInput) Refresh() {
+	t.rebuild()
+	t.BaseWidget.Refresh()
+}
+
+func (t *TagInput) addTag(s string) bool {
+	s = strings.TrimSpace(s)
+	if s == "" || slices.Contains(t.tags, s) {
+		return false
+	}
+	t.tags = append(t.tags, s)
+	return true
+}
+
+func (t *TagInput) rebuild() {
+	objs := make([]fyne.CanvasObject, 0, len(t.tags))
+	for _, s := range t.tags {
+		var fg, bg any
+		if t.Palette != nil {
+			fg, bg = t.Palette(s)
+		}
+		c := NewColorChip(s, nil, fg, bg)
+		if c == nil {
+			c = NewColorChip(s, nil, nil, nil)
+		}
+		c.OnDeleted = func() {
+			t.RemoveTag(s)
+		}
+		objs = append(objs, c)
+	}
+	t.chips.Objects = objs
+	t.chips.Refresh()
+}
+
+func (t *TagInput) changed() {
+	if t.OnChanged != nil {
+		t.OnChanged(t.GetTags())
+	}
+}
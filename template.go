@@ -0,0 +1,202 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Text built from a template like "{{.Host}}: {{.Status}}", re-rendered
+// when bound fields change, with optional styles per field.
+
+package colorlabel
+
+import (
+	"fmt"
+	"strings"
+
+	"fyne.io/fyne/v2/data/binding"
+)
+
+type templatePart struct {
+	text  string
+	field string
+}
+
+type templateState struct {
+	parts  []templatePart
+	data   map[string]any
+	styles map[string]Style
+}
+
+// Set a template, fields are written as {{.Name}} and looked up in data
+// Values can be bindings (binding.String, Float, Int or Bool), the text is
+// re-rendered when they change. Other values are formatted with fmt.Sprint,
+// missing fields are rendered empty. Unbind removes the bindings.
+// A template replaces a binding set by BindFloat or BindInt,
+// SetText and SetSegments remove the template and its bindings.
+func (l *ColorLabel) SetTemplate(tmpl string, data map[string]any) error {
+	parts, err := parseTemplate(tmpl)
+	if err != nil {
+		return err
+	}
+	l.Unbind()
+	l.tmpl.parts = parts
+	l.tmpl.data = make(map[string]any, len(data))
+	for k, v := range data {
+		l.tmpl.data[k] = v
+	}
+	l.bindTemplate()
+	l.renderTemplate()
+	return nil
+}
+
+// Set the value of a template field, a binding is listened to
+func (l *ColorLabel) SetTemplateValue(field string, v any) {
+	if l.tmpl.parts == nil {
+		return
+	}
+	l.tmpl.data[field] = v
+	l.bindTemplate()
+	l.renderTemplate()
+}
+
+// Set the style of a template field
+// Only TextColor, BackgroundColor and TextStyle of the style are used
+func (l *ColorLabel) SetTemplateFieldStyle(field string, style Style) error {
+	if err := validateStyle(style); err != nil {
+		return err
+	}
+	if style.TextStyle != nil {
+		s := *style.TextStyle
+		style.TextStyle = &s
+	}
+	if l.tmpl.styles == nil {
+		l.tmpl.styles = make(map[string]Style)
+	}
+	l.tmpl.styles[field] = style
+	if l.tmpl.parts != nil {
+		l.renderTemplate()
+	}
+	return nil
+}
+
+// Removes the template and its bindings
+func (l *ColorLabel) clearTemplate() {
+	if l.tmpl.parts != nil {
+		l.Unbind()
+		l.tmpl.parts = nil
+		l.tmpl.data = nil
+	}
+}
+
+func parseTemplate(tmpl string) ([]templatePart, error) {
+	parts := []templatePart{}
+	for tmpl != "" {
+		start := strings.Index(tmpl, "{{")
+		if start < 0 {
+			parts = append(parts, templatePart{text: tmpl})
+			break
+		}
+		if start > 0 {
+			parts = append(parts, templatePart{text: tmpl[:start]})
+		}
+		end := strings.Index(tmpl[start:], "}}")
+		if end < 0 {
+			return nil, fmt.Errorf("unclosed field at %d", start)
+		}
+		field := strings.TrimSpace(tmpl[start+2 : start+end])
+		if !strings.HasPrefix(field, ".") || len(field) == 1 || strings.ContainsAny(field, " \t") {
+			return nil, fmt.Errorf("invalid field %q, {{.Name}} expected", field)
+		}
+		parts = append(parts, templatePart{field: field[1:]})
+		tmpl = tmpl[start+end+2:]
+	}
+	return parts, nil
+}
+
+// Listens to all bindings of the template data
+func (l *ColorLabel) bindTemplate() {
+	l.Unbind()
+	listener := binding.NewDataListener(l.renderTemplate)
+	var items []binding.DataItem
+	for _, v := range l.tmpl.data {
+		if item, ok := v.(binding.DataItem); ok {
+			item.AddListener(listener)
+			items = append(items, item)
+		}
+	}
+	l.bind.unbind = func() {
+		for _, item := range items {
+			item.RemoveListener(listener)
+		}
+	}
+}
+
+func (l *ColorLabel) renderTemplate() {
+	var segs []Segment
+	styled := false
+	for _, p := range l.tmpl.parts {
+		if p.field == "" {
+			segs = append(segs, Segment{Text: p.text})
+			continue
+		}
+		seg := Segment{Text: templateValue(l.tmpl.data[p.field])}
+		if s, ok := l.tmpl.styles[p.field]; ok {
+			seg.TextColor = s.TextColor
+			seg.BackgroundColor = s.BackgroundColor
+			seg.TextStyle = s.TextStyle
+			styled = true
+		}
+		segs = append(segs, seg)
+	}
+	l.highlightBase = nil
+	if styled {
+		l.setSegments(segs)
+	} else {
+		var sb strings.Builder
+		for _, s := range segs {
+			sb.WriteString(s.Text)
+		}
+		l.fullText = sb.String()
+		l.segments = nil
+	}
+	l.Refresh()
+}
+
+func templateValue(v any) string {
+	var val any
+	var err error
+	switch b := v.(type) {
+	case nil:
+		return ""
+	case binding.String:
+		val, err = b.Get()
+	case binding.Float:
+		val, err = b.Get()
+	case binding.Int:
+		val, err = b.Get()
+	case binding.Bool:
+		val, err = b.Get()
+	default:
+		val = v
+	}
+	if err != nil {
+		return ""
+	}
+	return fmt.Sprint(val)
+}
@@ -0,0 +1,157 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Optional validation of theme color names. If enabled, unknown names
+// like "forground" are logged as warnings instead of silently rendering
+// in an unexpected color.
+
+package colorlabel
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+)
+
+var (
+	themeNameValidation atomic.Bool
+	themeNamesLock      sync.RWMutex
+	themeNames          = map[fyne.ThemeColorName]bool{
+		theme.ColorNameBackground:          true,
+		theme.ColorNameButton:              true,
+		theme.ColorNameDisabledButton:      true,
+		theme.ColorNameDisabled:            true,
+		theme.ColorNameError:               true,
+		theme.ColorNameFocus:               true,
+		theme.ColorNameForeground:          true,
+		theme.ColorNameForegroundOnError:   true,
+		theme.ColorNameForegroundOnPrimary: true,
+		theme.ColorNameForegroundOnSuccess: true,
+		theme.ColorNameForegroundOnWarning: true,
+		theme.ColorNameHeaderBackground:    true,
+		theme.ColorNameHover:               true,
+		theme.ColorNameHyperlink:           true,
+		theme.ColorNameInputBackground:     true,
+		theme.ColorNameInputBorder:         true,
+		theme.ColorNameMenuBackground:      true,
+		theme.ColorNameOverlayBackground:   true,
+		theme.ColorNamePlaceHolder:         true,
+		theme.ColorNamePressed:             true,
+		theme.ColorNamePrimary:             true,
+		theme.ColorNameScrollBar:           true,
+		theme.ColorNameScrollBarBackground: true,
+		theme.ColorNameSelection:           true,
+		theme.ColorNameSeparator:           true,
+		theme.ColorNameShadow:              true,
+		theme.ColorNameSuccess:             true,
+		theme.ColorNameWarning:             true,
+	}
+)
+
+// Enables or disables the validation of theme color names
+// If enabled, unknown names are logged as warning
+func SetThemeNameValidation(enabled bool) {
+	themeNameValidation.Store(enabled)
+}
+
+// Registers custom theme color names provided by the app theme
+func RegisterThemeColorName(names ...fyne.ThemeColorName) {
+	themeNamesLock.Lock()
+	defer themeNamesLock.Unlock()
+	for _, n := range names {
+		themeNames[n] = true
+	}
+}
+
+// Reports if name is a fyne or registered theme color name
+func IsKnownThemeColorName(name fyne.ThemeColorName) bool {
+	themeNamesLock.RLock()
+	defer themeNamesLock.RUnlock()
+	return themeNames[name]
+}
+
+func checkThemeName(c any) {
+	if !themeNameValidation.Load() {
+		return
+	}
+	var name fyne.ThemeColorName
+	switch v := c.(type) {
+	case string:
+		name = fyne.ThemeColorName(v)
+	case fyne.ThemeColorName:
+		name = v
+	default:
+		return
+	}
+	if name == "" || IsKnownThemeColorName(name) {
+		return
+	}
+	if _, ok := CSSColor(string(name)); ok {
+		return
+	}
+	msg := fmt.Sprintf("colorlabel: unknown theme color name %q", name)
+	if s := similarThemeName(name); s != "" {
+		msg += fmt.Sprintf(", did you mean %q?", s)
+	}
+	fyne.LogError(msg, nil)
+}
+
+// Returns the known name with the smallest edit distance, if it is close enough
+func similarThemeName(name fyne.ThemeColorName) fyne.ThemeColorName {
+	themeNamesLock.RLock()
+	defer themeNamesLock.RUnlock()
+	var best fyne.ThemeColorName
+	bestDist := 3
+	for n := range themeNames {
+		if d := editDistance(string(name), string(n)); d < bestDist || (d == bestDist && best != "" && n < best) {
+			best, bestDist = n, d
+		}
+	}
+	if bestDist >= 3 {
+		return ""
+	}
+	return best
+}
+
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(rb)]
+}
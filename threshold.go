@@ -0,0 +1,86 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Threshold based coloring of numeric values, e.g. <20 green,
+// <80 yellow, else red.
+
+package colorlabel
+
+import (
+	"slices"
+)
+
+// Colors used for values below a limit
+// TextColor and BackgroundColor are NRGBA or fyne.ThemeColorName,
+// nil keeps the current color. Use math.Inf(1) as limit for "else".
+type Threshold struct {
+	Below           float64
+	TextColor       any
+	BackgroundColor any
+}
+
+// Set the thresholds used for the value shown with UpdateValue
+// The first threshold with value < Below selects the colors.
+func (l *ColorLabel) SetValueRules(rules []Threshold) error {
+	for _, t := range rules {
+		if err := ValidateColor(t.TextColor); err != nil {
+			return err
+		}
+		if err := ValidateColor(t.BackgroundColor); err != nil {
+			return err
+		}
+	}
+	l.value.rules = slices.Clone(rules)
+	if l.value.valid {
+		l.applyValueColors(l.value.last)
+		l.Refresh()
+	}
+	return nil
+}
+
+// Get a copy of the thresholds
+func (l *ColorLabel) GetValueRules() []Threshold {
+	return slices.Clone(l.value.rules)
+}
+
+// Selects the colors for a value by ConditionalColor or the thresholds
+// The caller has to refresh the label, also if the text did not change
+func (l *ColorLabel) applyValueColors(v float64) {
+	var fg, bg any
+	if l.ConditionalColor != nil {
+		fg, bg = l.ConditionalColor(v)
+	} else {
+		i := slices.IndexFunc(l.value.rules, func(t Threshold) bool {
+			return v < t.Below
+		})
+		if i < 0 {
+			return
+		}
+		fg, bg = l.value.rules[i].TextColor, l.value.rules[i].BackgroundColor
+	}
+	if fg != nil && ValidateColor(fg) == nil {
+		l.fgColor = fg
+	}
+	if bg != nil && ValidateColor(bg) == nil {
+		l.bgColor = bg
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Internal ticker shared by many labels, so running labels do not
+// need a goroutine and a timer each.
+
+package colorlabel
+
+import (
+	"sync"
+	"time"
+
+	"fyne.io/fyne/v2"
+)
+
+// Ticker calling all subscribers on the fyne thread
+// The goroutine runs only while there are subscribers.
+type sharedTicker struct {
+	lock     sync.Mutex
+	interval time.Duration
+	subs     map[any]func()
+	stop     chan struct{}
+}
+
+func newSharedTicker(interval time.Duration) *sharedTicker {
+	return &sharedTicker{
+		interval: interval,
+		subs:     make(map[any]func()),
+	}
+}
+
+// Calls f on every tick until unsubscribe is called with the same key
+func (t *sharedTicker) subscribe(key any, f func()) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.subs[key] = f
+	if t.stop == nil {
+		t.stop = make(chan struct{})
+		go t.run(t.stop)
+	}
+}
+
+func (t *sharedTicker) unsubscribe(key any) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	delete(t.subs, key)
+	if len(t.subs) == 0 && t.stop != nil {
+		close(t.stop)
+		t.stop = nil
+	}
+}
+
+func (t *sharedTicker) run(stop chan struct{}) {
+	ticker := time.NewTicker(t.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			t.lock.Lock()
+			funcs := make([]func(), 0, len(t.subs))
+			for _, f := range t.subs {
+				funcs = append(funcs, f)
+			}
+			t.lock.Unlock()
+			fyne.Do(func() {
+				for _, f := range funcs {
+					f()
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,203 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// TimeLabel shows a timestamp relative to now, e.g. "5 minutes ago",
+// and refreshes itself while it is visible.
+
+package colorlabel
+
+import (
+	"fmt"
+	"time"
+
+	"fyne.io/fyne/v2"
+)
+
+// Label showing a time relative to now
+// The label refreshes every second or minute depending on the age of the
+// time. The timer only runs while the label is shown.
+type TimeLabel struct {
+	ColorLabel
+
+	t          time.Time
+	timer      *time.Timer
+	generation int
+	rendered   bool
+
+	// Formats the time, nil uses RelativeTime
+	Format func(t, now time.Time) string
+}
+
+// Creates a new TimeLabel
+func NewTimeLabel(t time.Time) *TimeLabel {
+	l := &TimeLabel{t: t}
+	l.initEmbedded()
+	l.ExtendBaseWidget(l)
+	l.fullText = l.format(time.Now())
+	return l
+}
+
+// Widget interface
+func (l *TimeLabel) CreateRenderer() fyne.WidgetRenderer {
+	l.rendered = true
+	// no refresh while the renderer is created
+	now := time.Now()
+	l.fullText = l.format(now)
+	l.schedule(now)
+	return &lifecycleRenderer{WidgetRenderer: l.ColorLabel.CreateRenderer(), destroy: func() {
+		l.rendered = false
+		l.stop()
+	}}
+}
+
+// Set a new time
+func (l *TimeLabel) SetTime(t time.Time) {
+	l.t = t
+	l.update()
+}
+
+// Get the time
+func (l *TimeLabel) GetTime() time.Time {
+	return l.t
+}
+
+// Hides the label and stops the timer
+func (l *TimeLabel) Hide() {
+	l.stop()
+	l.ColorLabel.Hide()
+}
+
+// Shows the label and restarts the timer
+func (l *TimeLabel) Show() {
+	l.ColorLabel.Show()
+	l.update()
+}
+
+// Returns t relative to now, e.g. "just now", "5 minutes ago", "yesterday" or "in 2 hours"
+func RelativeTime(t, now time.Time) string {
+	d := now.Sub(t)
+	future := d < 0
+	if future {
+		d = -d
+	}
+	unit := func(n int, name string) string {
+		s := fmt.Sprintf("%d %s", n, name)
+		if n != 1 {
+			s += "s"
+		}
+		if future {
+			return "in " + s
+		}
+		return s + " ago"
+	}
+	switch {
+	case d < 10*time.Second:
+		return "just now"
+	case d < time.Minute:
+		return unit(int(d/time.Second), "second")
+	case d < time.Hour:
+		return unit(int(d/time.Minute), "minute")
+	case d < 24*time.Hour:
+		return unit(int(d/time.Hour), "hour")
+	case d < 48*time.Hour:
+		if future {
+			return "tomorrow"
+		}
+		return "yesterday"
+	case d < 30*24*time.Hour:
+		return unit(int(d/(24*time.Hour)), "day")
+	}
+	return t.Format("2 Jan 2006")
+}
+
+// Sets the text and schedules the next update
+func (l *TimeLabel) update() {
+	now := time.Now()
+	l.SetText(l.format(now))
+	l.schedule(now)
+}
+
+func (l *TimeLabel) format(now time.Time) string {
+	if l.Format != nil {
+		return l.Format(l.t, now)
+	}
+	return RelativeTime(l.t, now)
+}
+
+func (l *TimeLabel) schedule(now time.Time) {
+	l.stop()
+	if !l.rendered || !l.Visible() {
+		return
+	}
+	age := now.Sub(l.t)
+	abs := age
+	if abs < 0 {
+		abs = -abs
+	}
+	var d time.Duration
+	switch {
+	case abs < time.Minute:
+		d = time.Second
+	case abs < time.Hour:
+		d = untilBoundary(age, time.Minute)
+	default:
+		d = untilBoundary(age, time.Hour)
+	}
+	gen := l.generation
+	l.timer = time.AfterFunc(d, func() {
+		fyne.Do(func() {
+			if l.generation == gen {
+				l.update()
+			}
+		})
+	})
+}
+
+// Returns the time until age crosses the next multiple of unit,
+// the age grows for past and shrinks for future timestamps
+func untilBoundary(age, unit time.Duration) time.Duration {
+	if age < 0 {
+		// the text changes just after -age dropped below the multiple
+		return -age%unit + time.Millisecond
+	}
+	return unit - age%unit
+}
+
+func (l *TimeLabel) stop() {
+	l.generation++
+	if l.timer != nil {
+		l.timer.Stop()
+		l.timer = nil
+	}
+}
+
+// Renderer wrapper calling destroy when the renderer is destroyed
+type lifecycleRenderer struct {
+	fyne.WidgetRenderer
+	destroy func()
+}
+
+// WidgetRenderer interface
+func (r *lifecycleRenderer) Destroy() {
+	r.destroy()
+	r.WidgetRenderer.Destroy()
+}
@@ -0,0 +1,285 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Toast notifications, a ColorLabel which slides in at the bottom of
+// a canvas and dismisses itself after a while.
+
+package colorlabel
+
+import (
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+const toastSlideDuration = 250 * time.Millisecond
+
+var (
+	_ fyne.Widget   = (*Toast)(nil)
+	_ fyne.Tappable = (*Toast)(nil)
+)
+
+// Notification which slides in at the bottom of a canvas, a tap dismisses it
+// While shown, the content of the canvas is stacked below a layer holding
+// the toast. The content outside of the toast stays usable.
+// Implements
+//   - fyne.Widget
+//   - fyne.Tappable
+type Toast struct {
+	widget.BaseWidget
+
+	label  *ColorLabel
+	action *ColorLabel
+	box    *fyne.Container
+
+	canvas     fyne.Canvas
+	layer      *toastLayer
+	anim       *fyne.Animation
+	timer      *time.Timer
+	generation int
+
+	// Called when the toast has been dismissed
+	OnDismissed func()
+}
+
+// Shows a toast with text and style at the bottom of canvas c
+// d is the time until the toast is dismissed, <= 0 keeps it until it is tapped
+// Returns nil if the style is invalid
+func ShowToast(c fyne.Canvas, text string, style Style, d time.Duration) *Toast {
+	t := NewToast(text, style)
+	if t != nil {
+		t.ShowOnCanvas(c, d)
+	}
+	return t
+}
+
+// Creates a new Toast, the style is applied to the text
+// Returns nil if the style is invalid
+func NewToast(text string, style Style) *Toast {
+	label := NewColorLabelWithStyle(text, style)
+	if label == nil {
+		return nil
+	}
+	t := &Toast{
+		label: label,
+	}
+	t.label.OnTapped = t.Dismiss
+	t.action = NewColorLabel("", theme.ColorNamePrimary, nil, 1)
+	t.action.SetTextStyle(&fyne.TextStyle{Bold: true})
+	t.action.Hide()
+	t.box = container.NewHBox(t.label, t.action)
+	t.ExtendBaseWidget(t)
+	return t
+}
+
+// Widget interface
+func (t *Toast) CreateRenderer() fyne.WidgetRenderer {
+	return widget.NewSimpleRenderer(t.box)
+}
+
+// Get the label showing the text
+func (t *Toast) GetLabel() *ColorLabel {
+	return t.label
+}
+
+// Shows an action label behind the text, f is called when it is tapped
+// and the toast is dismissed. An empty text removes the action.
+func (t *Toast) SetAction(text string, f func()) {
+	t.action.SetText(text)
+	t.action.OnTapped = func() {
+		t.Dismiss()
+		if f != nil {
+			f()
+		}
+	}
+	if text == "" {
+		t.action.Hide()
+	} else {
+		t.action.Show()
+	}
+	if t.layer != nil {
+		t.layer.place()
+	}
+}
+
+// Slides the toast in at the bottom center of canvas c
+// d is the time until the toast is dismissed, <= 0 keeps it until it is tapped
+func (t *Toast) ShowOnCanvas(c fyne.Canvas, d time.Duration) {
+	t.stop()
+	if t.layer != nil {
+		removeToastLayer(t.canvas, t.layer)
+	}
+	t.canvas = c
+	t.layer = newToastLayer(t, c.Content())
+	c.SetContent(container.NewStack(t.layer.content, t.layer))
+	t.slide(t.layer, 1, nil)
+	if d > 0 {
+		gen := t.generation
+		t.timer = time.AfterFunc(d, func() {
+			fyne.Do(func() {
+				if t.generation == gen {
+					t.Dismiss()
+				}
+			})
+		})
+	}
+}
+
+// Slides the toast out and hides it
+func (t *Toast) Dismiss() {
+	o := t.layer
+	if o == nil {
+		return
+	}
+	t.stop()
+	t.layer = nil
+	c := t.canvas
+	t.slide(o, 0, func() {
+		removeToastLayer(c, o)
+		if t.OnDismissed != nil {
+			t.OnDismissed()
+		}
+	})
+}
+
+// Tappable interface
+func (t *Toast) Tapped(_ *fyne.PointEvent) {
+	t.Dismiss()
+}
+
+// Animates the shown fraction of the toast towards to
+func (t *Toast) slide(o *toastLayer, to float32, done func()) {
+	from := o.shown
+	t.anim = fyne.NewAnimation(toastSlideDuration, func(f float32) {
+		o.shown = from + (to-from)*f
+		o.place()
+		if f >= 1 && done != nil {
+			done()
+		}
+	})
+	t.anim.Curve = fyne.AnimationEaseOut
+	t.anim.Start()
+}
+
+func (t *Toast) stop() {
+	t.generation++
+	if t.timer != nil {
+		t.timer.Stop()
+		t.timer = nil
+	}
+	if t.anim != nil {
+		t.anim.Stop()
+		t.anim = nil
+	}
+}
+
+// Layer above the content of a canvas holding a toast, the toast is placed
+// at the bottom center and moved down by the part which is not shown.
+// The layer itself is not tappable, taps beside the toast reach the content.
+type toastLayer struct {
+	widget.BaseWidget
+
+	toast *Toast
+	shown float32
+	// content of the canvas below the layer
+	content fyne.CanvasObject
+}
+
+func newToastLayer(t *Toast, content fyne.CanvasObject) *toastLayer {
+	if content == nil {
+		content = container.NewWithoutLayout()
+	}
+	o := &toastLayer{toast: t, content: content}
+	o.ExtendBaseWidget(o)
+	return o
+}
+
+// Removes layer o from the content of canvas c and restores the content below it
+// Layers of toasts shown later stay in place.
+func removeToastLayer(c fyne.Canvas, o *toastLayer) {
+	var outer *fyne.Container
+	content := c.Content()
+	for {
+		stack, ok := content.(*fyne.Container)
+		if !ok || len(stack.Objects) != 2 {
+			return
+		}
+		l, ok := stack.Objects[1].(*toastLayer)
+		if !ok {
+			return
+		}
+		if l != o {
+			outer = stack
+			content = l.content
+			continue
+		}
+		if outer == nil {
+			c.SetContent(o.content)
+			return
+		}
+		outer.Objects[0] = o.content
+		outer.Objects[1].(*toastLayer).content = o.content
+		outer.Refresh()
+		return
+	}
+}
+
+// Widget interface
+func (o *toastLayer) CreateRenderer() fyne.WidgetRenderer {
+	return &toastLayerRenderer{o: o}
+}
+
+// Places the toast according to the size of the layer
+func (o *toastLayer) place() {
+	size := o.toast.MinSize()
+	cs := o.Size()
+	o.toast.Resize(size)
+	y := cs.Height - (size.Height+2*theme.Padding())*o.shown
+	o.toast.Move(fyne.NewPos((cs.Width-size.Width)/2, y))
+}
+
+type toastLayerRenderer struct {
+	o *toastLayer
+}
+
+func (r *toastLayerRenderer) Layout(_ fyne.Size) {
+	r.o.place()
+}
+
+func (r *toastLayerRenderer) MinSize() fyne.Size {
+	return fyne.NewSize(0, 0)
+}
+
+func (r *toastLayerRenderer) Refresh() {
+	r.o.place()
+	r.o.toast.Refresh()
+}
+
+func (r *toastLayerRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.o.toast}
+}
+
+func (r *toastLayerRenderer) Destroy() {
+}
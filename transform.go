@@ -0,0 +1,109 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Text transforms (upper, lower and title case) applied at render time.
+// Casing is locale sensitive, e.g. for the Turkish dotted and dotless i.
+
+package colorlabel
+
+import (
+	"fyne.io/fyne/v2/lang"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+)
+
+type TextTransformType int
+
+const (
+	TransformNone TextTransformType = iota
+	TransformUpper
+	TransformLower
+	TransformTitle
+)
+
+// Set a text transform applied when rendering the text
+// The text returned by GetText is not changed
+func (l *ColorLabel) SetTextTransform(t TextTransformType) {
+	if l.transform != t {
+		l.transform = t
+		l.Refresh()
+	}
+}
+
+// Get the text transform
+func (l *ColorLabel) GetTextTransform() TextTransformType {
+	return l.transform
+}
+
+// Set the locale used for text transforms and SetNumber
+// language.Und uses the locale of the system
+func (l *ColorLabel) SetLocale(tag language.Tag) {
+	if l.locale != tag {
+		l.locale = tag
+		l.Refresh()
+	}
+}
+
+// Get the locale used for text transforms and SetNumber
+func (l *ColorLabel) GetLocale() language.Tag {
+	if l.locale != language.Und {
+		return l.locale
+	}
+	return systemLocale()
+}
+
+func systemLocale() language.Tag {
+	tag, err := language.Parse(string(lang.SystemLocale()))
+	if err != nil {
+		return language.Und
+	}
+	return tag
+}
+
+// Applies the text transform with the locale of the label
+func (l *ColorLabel) transformText(s string) string {
+	var c cases.Caser
+	switch l.transform {
+	case TransformUpper:
+		c = cases.Upper(l.GetLocale())
+	case TransformLower:
+		c = cases.Lower(l.GetLocale())
+	case TransformTitle:
+		c = cases.Title(l.GetLocale())
+	default:
+		return s
+	}
+	return c.String(s)
+}
+
+// Returns the text to display, derived from the full text
+// The placeholder is returned if the text is empty, masked text as bullets,
+// tabs are expanded in monospace mode
+func (l *ColorLabel) displayText() string {
+	if l.showsPlaceholder() {
+		return l.placeholder.text
+	}
+	if l.masked() {
+		return l.maskText(l.fullText)
+	}
+	return l.transformText(l.expandTabs(l.fullText))
+}
@@ -0,0 +1,151 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// TreeAdapter provides the callbacks of a widget.Tree showing the nodes
+// as ColorLabels styled by depth and node kind.
+
+package colorlabel
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// Adapter between tree data and a widget.Tree with ColorLabel nodes
+// The depth of a node is recorded while the tree asks for the children,
+// the children of the root have depth 0.
+type TreeAdapter struct {
+	childUIDs func(uid widget.TreeNodeID) []widget.TreeNodeID
+	isBranch  func(uid widget.TreeNodeID) bool
+	text      func(uid widget.TreeNodeID) string
+	depth     map[widget.TreeNodeID]int
+	tree      *widget.Tree
+
+	// Returns the style of a node, nil shows branches bold
+	Style func(uid widget.TreeNodeID, depth int, branch bool) Style
+	// Called when a node is tapped
+	OnNodeTapped func(uid widget.TreeNodeID)
+}
+
+// Creates a new TreeAdapter
+// childUIDs and isBranch are the callbacks known from widget.Tree,
+// text returns the text of a node
+func NewTreeAdapter(childUIDs func(uid widget.TreeNodeID) []widget.TreeNodeID,
+	isBranch func(uid widget.TreeNodeID) bool, text func(uid widget.TreeNodeID) string) *TreeAdapter {
+	return &TreeAdapter{
+		childUIDs: childUIDs,
+		isBranch:  isBranch,
+		text:      text,
+		depth:     make(map[widget.TreeNodeID]int),
+	}
+}
+
+// Style of the nodes if no Style is set, branches are bold
+func defaultTreeStyle(_ widget.TreeNodeID, _ int, branch bool) Style {
+	if branch {
+		return Style{TextStyle: &fyne.TextStyle{Bold: true}}
+	}
+	return Style{}
+}
+
+// Style with bold branches and dimmed leaf nodes
+func DimLeafStyle(uid widget.TreeNodeID, depth int, branch bool) Style {
+	st := defaultTreeStyle(uid, depth, branch)
+	if !branch {
+		st.TextColor = theme.ColorNamePlaceHolder
+	}
+	return st
+}
+
+// Creates a new widget.Tree using the adapter
+func (a *TreeAdapter) NewTree() *widget.Tree {
+	t := widget.NewTree(a.ChildUIDs, a.IsBranch, a.CreateNode, a.UpdateNode)
+	a.Attach(t)
+	return t
+}
+
+// Connects the adapter to a tree created with its callbacks, so a tap
+// on a label selects the node
+func (a *TreeAdapter) Attach(t *widget.Tree) {
+	a.tree = t
+}
+
+// Returns the depth of a node, -1 if it is unknown
+func (a *TreeAdapter) Depth(uid widget.TreeNodeID) int {
+	if uid == "" {
+		return -1
+	}
+	d, ok := a.depth[uid]
+	if !ok {
+		return -1
+	}
+	return d
+}
+
+// ChildUIDs callback of widget.Tree
+func (a *TreeAdapter) ChildUIDs(uid widget.TreeNodeID) []widget.TreeNodeID {
+	children := a.childUIDs(uid)
+	d := 0
+	if uid != "" {
+		d = a.depth[uid] + 1
+	}
+	for _, c := range children {
+		a.depth[c] = d
+	}
+	return children
+}
+
+// IsBranch callback of widget.Tree
+func (a *TreeAdapter) IsBranch(uid widget.TreeNodeID) bool {
+	return a.isBranch(uid)
+}
+
+// CreateNode callback of widget.Tree
+func (a *TreeAdapter) CreateNode(_ bool) fyne.CanvasObject {
+	l := NewColorLabel("", nil, nil, 1)
+	l.SetTruncateMode(End)
+	return l
+}
+
+// UpdateNode callback of widget.Tree
+func (a *TreeAdapter) UpdateNode(uid widget.TreeNodeID, branch bool, o fyne.CanvasObject) {
+	l := o.(*ColorLabel)
+	style := a.Style
+	if style == nil {
+		style = defaultTreeStyle
+	}
+	st := style(uid, a.Depth(uid), branch)
+	if st.Truncate == None {
+		st.Truncate = End
+	}
+	l.ApplyStyle(st)
+	l.SetText(a.text(uid))
+	l.OnTapped = func() {
+		if a.tree != nil {
+			a.tree.Select(uid)
+		}
+		if a.OnNodeTapped != nil {
+			a.OnNodeTapped(uid)
+		}
+	}
+}
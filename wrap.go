@@ -0,0 +1,228 @@
+// Copyright (c) 2025 Reiner Pröls
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+//
+// Wrapping at word boundaries to a maximum number of lines,
+// the last line ends with the ellipsis if the text does not fit.
+
+package colorlabel
+
+import (
+	"image/color"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+)
+
+type wrapState struct {
+	maxLines    int
+	lastHidden  int
+	linesNeeded int
+}
+
+type wrapView struct {
+	box        *fyne.Container
+	lines      []*canvas.Text
+	count      int
+	lineHeight float32
+}
+
+// Set the maximum number of lines, the text is wrapped at word boundaries
+// and the last line ends with the ellipsis if the text needs more lines.
+// 0 shows a single line without wrapping, -1 wraps without limit.
+// Segments are not wrapped, they are shown in a single line.
+func (l *ColorLabel) SetMaxLines(n int) {
+	if n < 0 {
+		n = -1
+	}
+	if l.wrap.maxLines != n {
+		l.wrap.maxLines = n
+		l.Refresh()
+	}
+}
+
+// Get the maximum number of lines
+func (l *ColorLabel) GetMaxLines() int {
+	return l.wrap.maxLines
+}
+
+// Get the number of lines the text needs at the current width
+func (l *ColorLabel) GetLinesNeeded() int {
+	return l.wrap.linesNeeded
+}
+
+// Reports if the text is wrapped
+func (l *ColorLabel) wraps() bool {
+	return l.wrap.maxLines != 0
+}
+
+// Splits the text into lines fitting into width, words longer than a line are broken
+// Explicit line breaks are kept, width <= 0 wraps at the line breaks only
+func wrapLines(s string, width, size float32, style fyne.TextStyle) []string {
+	var lines []string
+	fits := func(t string) bool {
+		return width <= 0 || fyne.MeasureText(t, size, style).Width <= width
+	}
+	for _, para := range strings.Split(s, "\n") {
+		line := ""
+		for _, word := range strings.Fields(para) {
+			cand := word
+			if line != "" {
+				cand = line + " " + word
+			}
+			if fits(cand) {
+				line = cand
+				continue
+			}
+			if line != "" {
+				lines = append(lines, line)
+			}
+			g := graphemes(word)
+			// a single grapheme wider than width is kept on its own line
+			for len(g) > 1 && !fits(strings.Join(g, "")) {
+				n := len(g) - 1
+				for n > 1 && !fits(strings.Join(g[:n], "")) {
+					n--
+				}
+				lines = append(lines, strings.Join(g[:n], ""))
+				g = g[n:]
+			}
+			line = strings.Join(g, "")
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// Shortens s until it fits into width together with the ellipsis
+func ellipsizeLine(s, ell string, width, size float32, style fyne.TextStyle) string {
+	g := graphemes(strings.TrimRight(s, " "))
+	for keep := len(g); keep > 0; keep-- {
+		t := strings.Join(g[:keep], "") + ell
+		if fyne.MeasureText(t, size, style).Width <= width {
+			return t
+		}
+	}
+	return ell
+}
+
+func (r *ColorLabelRenderer) setWrappedTextProperties(fg color.Color) {
+	if r.wrap == nil {
+		r.wrap = &wrapView{box: container.NewWithoutLayout()}
+		r.wrap.box.Resize(r.text.Size())
+		r.wrap.box.Move(r.text.Position())
+		// insert in front of the caret
+		r.objs = append(r.objs[:len(r.objs)-1], r.wrap.box, r.caret)
+	}
+	if r.native != nil {
+		r.native.wrap.Hide()
+	}
+	r.text.Hide()
+	r.text.Text = r.source
+	r.text.Color = fg
+	r.measuredValid = false
+	r.wrap.box.Show()
+
+	size := r.text.TextSize
+	style := r.text.TextStyle
+	width := r.textWidth()
+	lines := wrapLines(r.source, width, size, style)
+	r.w.wrap.linesNeeded = len(lines)
+	truncated := r.w.wrap.maxLines > 0 && len(lines) > r.w.wrap.maxLines
+	if truncated {
+		lines = lines[:r.w.wrap.maxLines]
+		last := len(lines) - 1
+		lines[last] = ellipsizeLine(lines[last], r.w.GetEllipsis(), width, size, style)
+	}
+	r.w.renderedText = strings.Join(lines, "\n")
+	r.w.truncated = truncated
+
+	count := len(lines)
+	lh := fyne.MeasureText("M", size, style).Height * r.w.GetLineSpacing()
+	changed := count != r.wrap.count || lh != r.wrap.lineHeight
+	r.wrap.count = count
+	r.wrap.lineHeight = lh
+	for len(r.wrap.lines) < count {
+		t := canvas.NewText("", fg)
+		r.wrap.lines = append(r.wrap.lines, t)
+		r.wrap.box.Add(t)
+	}
+	boxSize := r.text.Size()
+	y := max((boxSize.Height-r.wrappedMinSize().Height)/2, 0)
+	for i, t := range r.wrap.lines {
+		if i >= count {
+			t.Hide()
+			continue
+		}
+		t.Text = lines[i]
+		t.Color = fg
+		t.TextSize = size
+		t.TextStyle = style
+		t.Alignment = r.text.Alignment
+		t.Move(fyne.NewPos(0, y+float32(i)*lh))
+		t.Resize(fyne.NewSize(boxSize.Width, fyne.MeasureText("M", size, style).Height))
+		t.Show()
+		t.Refresh()
+	}
+	if changed {
+		// the minimum height depends on the width, let the parent layout again
+		canvas.Refresh(r.w)
+	}
+	r.checkLineOverflow()
+}
+
+func (r *ColorLabelRenderer) hideWrapped() {
+	if r.wrap != nil {
+		r.wrap.box.Hide()
+		r.text.Show()
+	}
+}
+
+// Returns the minimum size of the wrapped text without padding
+// lineHeight is the distance of the lines including the line spacing
+func (r *ColorLabelRenderer) wrappedMinSize() fyne.Size {
+	m := fyne.MeasureText("M", r.text.TextSize, r.text.TextStyle)
+	if r.wrap.count == 0 {
+		return fyne.NewSize(m.Width, 0)
+	}
+	return fyne.NewSize(m.Width, m.Height+float32(r.wrap.count-1)*r.wrap.lineHeight)
+}
+
+// Calls OnLinesOverflow if the text needs more than the maximum number of lines
+func (r *ColorLabelRenderer) checkLineOverflow() {
+	if r.w.OnLinesOverflow == nil || r.maxWidth <= 0 || r.w.wrap.maxLines <= 0 {
+		return
+	}
+	hidden := r.w.wrap.linesNeeded - r.w.wrap.maxLines
+	if hidden <= 0 {
+		r.w.wrap.lastHidden = 0
+		return
+	}
+	if hidden != r.w.wrap.lastHidden {
+		r.w.wrap.lastHidden = hidden
+		f := r.w.OnLinesOverflow
+		fyne.Do(func() {
+			f(hidden)
+		})
+	}
+}